@@ -2,14 +2,24 @@ package live
 
 import (
 	"encoding/base64"
-	"net/url"
+	"fmt"
+	"strings"
 
 	"github.com/autonomouskoi/core-tinygo"
+	"github.com/autonomouskoi/core-tinygo/svc"
 )
 
 func (p *Plugin) handleCommand() core.TypeRouter {
 	return core.TypeRouter{
-		int32(MessageTypeCommand_TOKEN_SET_REQ): p.handleCommandSetToken,
+		int32(MessageTypeCommand_CONFIG_SET_REQ):      p.handleCommandSetConfig,
+		int32(MessageTypeCommand_TOKEN_SET_REQ):       p.handleCommandSetToken,
+		int32(MessageTypeCommand_TEST_CONNECTION_REQ): p.handleCommandTestConnection,
+		int32(MessageTypeCommand_SESSION_START_REQ):   p.handleCommandSessionStart,
+		int32(MessageTypeCommand_SESSION_END_REQ):     p.handleCommandSessionEnd,
+		int32(MessageTypeCommand_SESSION_RESUME_REQ):  p.handleCommandSessionResume,
+		int32(MessageTypeCommand_HIDE_NEXT_REQ):       p.handleCommandHideNext,
+		int32(MessageTypeCommand_MANUAL_TRACK_REQ):    p.handleCommandManualTrack,
+		int32(MessageTypeCommand_RECONCILE_REQ):       p.handleCommandReconcile,
 	}
 }
 
@@ -20,42 +30,143 @@ func (p *Plugin) handleCommandSetToken(msg *core.BusMessage) *core.BusMessage {
 		return reply
 	}
 	if req.RawToken == "" {
-		if req.Enabled == nil {
-			delete(p.cfg.Tokens, req.GetLabel())
-		} else {
-			t, present := p.cfg.Tokens[req.Label]
-			if !present {
-				return reply
-			}
-			t.Enabled = req.GetEnabled()
-		}
-		p.writeCfg()
-		return reply
+		return p.updateToken(reply, &req)
 	}
 
-	b, err := base64.StdEncoding.DecodeString(req.RawToken)
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(req.RawToken))
 	if err != nil {
 		core.LogError("decoding token", "error", err.Error())
-		reply.Error = core.InvalidTypeError(err)
+		reply.Error = tokenError(TokenErrorCode_TOKEN_ERROR_INVALID, "This isn't a token", err)
 		return reply
 	}
 	t := &Token{}
 	if err := t.UnmarshalVT(b); err != nil {
 		core.LogError("unmarshalling token", "length", len(b), "error", err.Error())
-		reply.Error = core.InvalidTypeError(err)
+		reply.Error = tokenError(TokenErrorCode_TOKEN_ERROR_INVALID, "This isn't a token", err)
+		return reply
+	}
+	now, _, err := svc.CurrentTimeMillis()
+	if err != nil {
+		reply.Error = core.BusError(err)
+		return reply
+	}
+	if reply.Error = validateToken(t, req.GetAllowInsecure(), !req.GetSkipVerify(), now); reply.Error != nil {
+		core.LogError("invalid token",
+			"issuer", t.GetIssuer(),
+			"subject", t.GetSubject(),
+			"error", reply.Error.GetDetail(),
+		)
 		return reply
 	}
-	u, err := url.Parse(t.Issuer)
+	label, err := viewerURL(t)
 	if err != nil {
 		core.LogError("parsing token issuer", "issuer", t.Issuer, "error", err.Error())
 		reply.Error = core.InvalidTypeError(err)
 		return reply
 	}
-	u.Path = "/u/" + t.GetSubject()
-	p.cfg.Tokens[u.String()] = &TokenConfig{
-		Token:   t,
-		Enabled: true,
+	if req.GetLabel() != "" {
+		label = req.GetLabel()
+	}
+
+	tokenID := req.GetTokenId()
+	if tokenID == "" {
+		if tokenID, err = newTokenID(); err != nil {
+			reply.Error = core.BusError(err)
+			return reply
+		}
+		p.cfg.Tokens[tokenID] = &TokenConfig{
+			Token:   t,
+			Enabled: true,
+			Label:   label,
+		}
+	} else {
+		// replacing the token, e.g. with a new one from the same server,
+		// keeps the rest of its settings
+		tCfg, present := p.cfg.Tokens[tokenID]
+		if !present {
+			reply.Error = core.NotFoundError()
+			return reply
+		}
+		tCfg.Token = t
+		if req.GetLabel() != "" {
+			tCfg.Label = req.GetLabel()
+		}
 	}
 	p.writeCfg()
+	core.MarshalMessage(reply, &TokenSetResponse{
+		TokenId: tokenID,
+	})
+	return reply
+}
+
+// updateToken changes the settings of an existing token, or deletes it if
+// there are no settings in req
+func (p *Plugin) updateToken(reply *core.BusMessage, req *TokenSetRequest) *core.BusMessage {
+	tokenID := req.GetTokenId()
+	if req.Label == "" && req.Enabled == nil && req.DelayMs == nil && req.DelayUntilNext == nil {
+		delete(p.cfg.Tokens, tokenID)
+		p.writeCfg()
+		return reply
+	}
+	t, present := p.cfg.Tokens[tokenID]
+	if !present {
+		reply.Error = core.NotFoundError()
+		return reply
+	}
+	if req.Label != "" {
+		t.Label = req.GetLabel()
+	}
+	if req.Enabled != nil {
+		t.Enabled = req.GetEnabled()
+	}
+	if req.DelayMs != nil {
+		if req.GetDelayMs() < 0 {
+			reply.Error = core.InvalidTypeError(fmt.Errorf("negative delay: %d", req.GetDelayMs()))
+			return reply
+		}
+		t.DelayMs = req.GetDelayMs()
+	}
+	if req.DelayUntilNext != nil {
+		t.DelayUntilNext = req.GetDelayUntilNext()
+		if !t.DelayUntilNext && p.releaseHeld(tokenID) {
+			p.writeOutboxes()
+			sendQueueEvent()
+		}
+	}
+	p.writeCfg()
+	core.MarshalMessage(reply, &TokenSetResponse{
+		TokenId: tokenID,
+	})
+	return reply
+}
+
+// handleCommandTestConnection checks that a token's server is reachable and
+// accepts the token, without sending a track
+func (p *Plugin) handleCommandTestConnection(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req TestConnectionRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	tCfg, present := p.cfg.Tokens[req.GetTokenId()]
+	if !present {
+		reply.Error = core.NotFoundError()
+		return reply
+	}
+	start, _, err := svc.CurrentTimeMillis()
+	if err != nil {
+		reply.Error = core.BusError(err)
+		return reply
+	}
+	resp := &TestConnectionResponse{}
+	if err := ping(tCfg.GetToken()); err != nil {
+		resp.Error = err.Error()
+	} else {
+		resp.Ok = true
+	}
+	if end, _, err := svc.CurrentTimeMillis(); err == nil {
+		resp.DurationMs = end - start
+	}
+	core.MarshalMessage(reply, resp)
 	return reply
 }
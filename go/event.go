@@ -1,9 +1,13 @@
 package live
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/autonomouskoi/core-tinygo"
 	"github.com/autonomouskoi/core-tinygo/svc"
@@ -21,59 +25,252 @@ func (p *Plugin) handleTrackstarEventTrackUpdate(msg *core.BusMessage) *core.Bus
 	if err := core.UnmarshalMessage(msg, &tu); err != nil {
 		return nil
 	}
+	if _, err := p.publish(&tu, msg.GetMessage()); err != nil {
+		core.LogError("publishing track update", "error", err.Error())
+	}
+	return nil
+}
+
+// publish queues tu to be sent with every enabled token. tuBytes is tu as it
+// was marshalled, preserving fields the plugin doesn't know about. The update
+// is given the next index in the current session, which is returned. If the
+// rules skip the update, the index is zero.
+func (p *Plugin) publish(tu *trackstar.TrackUpdate, tuBytes []byte) (int32, error) {
+	publish, modified := p.applyRules(tu)
+	if !publish {
+		return 0, nil
+	}
+	if modified {
+		var err error
+		if tuBytes, err = tu.MarshalVT(); err != nil {
+			return 0, fmt.Errorf("marshalling track update: %w", err)
+		}
+	}
+
+	now, _, err := svc.CurrentTimeMillis()
+	if err != nil {
+		return 0, fmt.Errorf("getting current time: %w", err)
+	}
+	session, err := p.currentSession(now)
+	if err != nil {
+		return 0, fmt.Errorf("starting session: %w", err)
+	}
+	index := p.session.LastIndex + 1
+	if tuBytes, err = setIndex(tuBytes, index); err != nil {
+		return 0, err
+	}
+	p.session.LastIndex = index
+	p.session.LastTrackAt = now
+	p.writeSession()
+	p.recordHistory(session, tuBytes)
 
-	for _, tCfg := range p.cfg.GetTokens() {
+	for tokenID, tCfg := range p.cfg.GetTokens() {
 		if !tCfg.Enabled {
 			continue
 		}
-		token := tCfg.GetToken()
-		u, err := url.Parse(token.GetIssuer())
-		if err != nil {
-			sendTSLEvent(nil, fmt.Errorf("parsing issuer URL: %w", err))
-			core.LogError("parsing issuer URL",
-				"issuer", token.GetIssuer(),
-				"error", err.Error(),
-			)
-			continue
+		qu := &QueuedUpdate{
+			TrackUpdate: tuBytes,
+			Session:     session,
 		}
-		u.Path = path.Join(u.Path,
-			"_trackUpdate",
-			token.GetSubject(),
-			p.session,
-		)
-		core.LogDebug("sending POST", "url", u.String())
-		httpReq := &svc.WebclientHTTPRequest{
-			Request: &svc.HTTPRequest{
-				Method: "POST",
-				Url:    u.String(),
-				Header: map[string]*svc.StringValues{
-					"x-extension-jwt": {Values: []string{token.GetRawToken()}},
-					"Content-Type":    {Values: []string{"application/protobuf"}},
-				},
-			},
-			RequestBody: &svc.BodyDisposition{
-				BodyAs: &svc.BodyDisposition_Inline{Inline: msg.GetMessage()},
-			},
+		switch {
+		case tCfg.GetDelayUntilNext():
+			// this track starting releases the previous one
+			p.releaseHeld(tokenID)
+			qu.HeldForNext = true
+		case tCfg.GetDelayMs() > 0:
+			qu.ReleaseAt = now + tCfg.GetDelayMs()
 		}
-		resp, err := svc.WebclientRequest(httpReq, 5000)
-		if err != nil {
-			sendTSLEvent(nil, fmt.Errorf("sending HTTP request: %w", err))
-			core.LogError("sending HTTP request", "error", err.Error())
-			if be, ok := err.(*core.Error); ok {
-				core.LogBusError("sending HTTP request", be)
-			}
-			continue
+		p.enqueue(tokenID, qu)
+	}
+	p.writeOutboxes()
+	sendQueueEvent()
+
+	p.drainAll(now, true)
+	return index, nil
+}
+
+// setIndex sets the index of a marshalled track update. Indexes are assigned
+// by the plugin so manual tracks and tracks from Trackstar are numbered
+// together within a session. See IndexedTrackUpdate for where the field is
+// defined.
+func setIndex(tuBytes []byte, index int32) ([]byte, error) {
+	var itu IndexedTrackUpdate
+	if err := itu.UnmarshalVT(tuBytes); err != nil {
+		return nil, fmt.Errorf("unmarshalling track update index: %w", err)
+	}
+	itu.Index = index
+	b, err := itu.MarshalVT()
+	if err != nil {
+		return nil, fmt.Errorf("marshalling track update index: %w", err)
+	}
+	return b, nil
+}
+
+// handleCommandManualTrack publishes a track entered by hand, e.g. from vinyl,
+// the same way as one detected by Trackstar
+func (p *Plugin) handleCommandManualTrack(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req ManualTrackRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	if req.GetArtist() == "" && req.GetTitle() == "" {
+		reply.Error = core.InvalidTypeError(errors.New("artist or title required"))
+		userMessage := "An artist or title is required"
+		reply.Error.UserMessage = &userMessage
+		return reply
+	}
+	now, _, err := svc.CurrentTimeMillis()
+	if err != nil {
+		reply.Error = core.BusError(err)
+		return reply
+	}
+	tu := &trackstar.TrackUpdate{
+		DeckId: req.GetDeckId(),
+		Track: &trackstar.Track{
+			Artist: req.GetArtist(),
+			Title:  req.GetTitle(),
+		},
+		When: now,
+	}
+	tuBytes, err := tu.MarshalVT()
+	if err != nil {
+		reply.Error = core.BusError(err)
+		return reply
+	}
+	index, err := p.publish(tu, tuBytes)
+	if err != nil {
+		core.LogError("publishing manual track", "error", err.Error())
+		reply.Error = core.BusError(err)
+		return reply
+	}
+	core.MarshalMessage(reply, &ManualTrackResponse{
+		Index: index,
+	})
+	return reply
+}
+
+// sendUpdate posts a marshalled track update to the server that issued token.
+// If sending fails, retry indicates whether trying again might succeed.
+func sendUpdate(token *Token, session string, tuBytes []byte, timeoutMS uint64) (bool, error) {
+	u, err := url.Parse(token.GetIssuer())
+	if err != nil {
+		return false, fmt.Errorf("parsing issuer URL: %w", err)
+	}
+	u.Path = path.Join(u.Path,
+		"_trackUpdate",
+		token.GetSubject(),
+		session,
+	)
+	core.LogDebug("sending POST", "url", u.String())
+	httpReq := &svc.WebclientHTTPRequest{
+		Request: &svc.HTTPRequest{
+			Method: "POST",
+			Url:    u.String(),
+			Header: map[string]*svc.StringValues{
+				"x-extension-jwt": {Values: []string{token.GetRawToken()}},
+				"Content-Type":    {Values: []string{"application/protobuf"}},
+			},
+		},
+		RequestBody: &svc.BodyDisposition{
+			BodyAs: &svc.BodyDisposition_Inline{Inline: tuBytes},
+		},
+	}
+	resp, err := svc.WebclientRequest(httpReq, timeoutMS)
+	if err != nil {
+		if be, ok := err.(*core.Error); ok {
+			core.LogBusError("sending HTTP request", be)
 		}
-		if resp.StatusCode != 200 {
-			sendTSLEvent(nil, fmt.Errorf("non-200 status: %s", resp.GetStatus()))
-			core.LogError("non-200 status sending HTTP request", "status", resp.GetStatus())
-			continue
+		return true, fmt.Errorf("sending HTTP request: %w", err)
+	}
+	if resp.StatusCode == 429 {
+		return true, &throttledError{retryAfter: headerValue(resp.GetHeader(), "Retry-After")}
+	}
+	if resp.StatusCode != 200 {
+		return retryable(resp.StatusCode), fmt.Errorf("non-200 status: %s", resp.GetStatus())
+	}
+	return false, nil
+}
+
+// throttledError is returned when the server rate limits a send. That isn't a
+// failure of the destination, it just has to be retried when the server says.
+type throttledError struct {
+	// retryAfter is the response's Retry-After header
+	retryAfter string
+}
+
+func (te *throttledError) Error() string {
+	if te.retryAfter == "" {
+		return "throttled"
+	}
+	return "throttled, retry after " + te.retryAfter
+}
+
+// headerValue gets the first value of a header, whatever its case
+func headerValue(header map[string]*svc.StringValues, name string) string {
+	for key, values := range header {
+		if strings.EqualFold(key, name) && len(values.GetValues()) > 0 {
+			return values.GetValues()[0]
 		}
-		sendTSLEvent(&tu, nil)
+	}
+	return ""
+}
+
+// retryAfterMS is how long from now, in milliseconds, a Retry-After header
+// value asks a client to wait. It's either a number of seconds or an HTTP
+// date. If it's neither, or in the past, 0 is returned.
+func retryAfterMS(retryAfter string, now int64) int64 {
+	retryAfter = strings.TrimSpace(retryAfter)
+	if secs, err := strconv.ParseInt(retryAfter, 10, 64); err == nil {
+		return max(secs, 0) * 1000
+	}
+	if t, err := time.Parse(httpTimeFormat, retryAfter); err == nil {
+		return max(t.UnixMilli()-now, 0)
+	}
+	return 0
+}
+
+// httpTimeFormat is the format of dates in HTTP headers
+const httpTimeFormat = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+// ping posts a no-op to the server that issued token, checking that the
+// server is reachable and accepts the token.
+func ping(token *Token) error {
+	u, err := url.Parse(token.GetIssuer())
+	if err != nil {
+		return fmt.Errorf("parsing issuer URL: %w", err)
+	}
+	u.Path = path.Join(u.Path, "_ping")
+	resp, err := svc.WebclientRequest(&svc.WebclientHTTPRequest{
+		Request: &svc.HTTPRequest{
+			Method: "POST",
+			Url:    u.String(),
+			Header: map[string]*svc.StringValues{
+				"x-extension-jwt": {Values: []string{token.GetRawToken()}},
+			},
+		},
+	}, sendTimeoutMS)
+	if err != nil {
+		return fmt.Errorf("sending HTTP request: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("non-200 status: %s", resp.GetStatus())
 	}
 	return nil
 }
 
+// retryable reports whether a request that got statusCode might succeed if
+// sent again. Client errors other than timeouts and throttling won't.
+func retryable(statusCode int32) bool {
+	switch {
+	case statusCode == 408, statusCode == 429:
+		return true
+	case statusCode >= 400 && statusCode < 500:
+		return false
+	}
+	return true
+}
+
 func sendTSLEvent(tu *trackstar.TrackUpdate, err error) {
 	msg := &core.BusMessage{
 		Topic: BusTopic_TRACKSTAR_LIVE_EVENT.String(),
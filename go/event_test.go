@@ -0,0 +1,45 @@
+package live
+
+import (
+	"testing"
+	"time"
+
+	"github.com/autonomouskoi/core-tinygo/svc"
+)
+
+func TestRetryAfterMS(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC).UnixMilli()
+	for _, tc := range []struct {
+		retryAfter string
+		want       int64
+	}{
+		{"10", 10000},
+		{" 3 ", 3000},
+		{"0", 0},
+		{"-5", 0},
+		{"Mon, 19 Oct 2026 12:00:30 GMT", 30000},
+		{"Mon, 19 Oct 2026 11:00:00 GMT", 0},
+		{"", 0},
+		{"soon", 0},
+	} {
+		if got := retryAfterMS(tc.retryAfter, now); got != tc.want {
+			t.Errorf("retryAfterMS(%q) = %d, want %d", tc.retryAfter, got, tc.want)
+		}
+	}
+}
+
+func TestHeaderValue(t *testing.T) {
+	header := map[string]*svc.StringValues{
+		"Retry-After": {Values: []string{"10"}},
+		"Empty":       {},
+	}
+	if got := headerValue(header, "retry-after"); got != "10" {
+		t.Errorf("got %q, want 10", got)
+	}
+	if got := headerValue(header, "Empty"); got != "" {
+		t.Errorf("got %q for a header without values", got)
+	}
+	if got := headerValue(nil, "Retry-After"); got != "" {
+		t.Errorf("got %q for no headers", got)
+	}
+}
@@ -0,0 +1,197 @@
+package live
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/autonomouskoi/akcore"
+	"github.com/autonomouskoi/core-tinygo"
+	"github.com/autonomouskoi/core-tinygo/svc"
+)
+
+var (
+	historyKVKey = []byte("history")
+)
+
+// historyMaxUpdates caps how many updates are kept for one session
+const historyMaxUpdates = 1000
+
+func (p *Plugin) loadHistory() error {
+	p.history = &History{
+		Sessions: map[string]*SessionHistory{},
+	}
+	if err := core.KVGetProto(historyKVKey, p.history); err != nil && !errors.Is(err, akcore.ErrNotFound) {
+		return fmt.Errorf("retrieving history: %w", err)
+	}
+	if p.history.Sessions == nil {
+		p.history.Sessions = map[string]*SessionHistory{}
+	}
+	return nil
+}
+
+func (p *Plugin) writeHistory() {
+	if err := core.KVSetProto(historyKVKey, p.history); err != nil {
+		core.LogError("writing history", "error", err.Error())
+	}
+}
+
+// recordHistory adds a published update to session's history. Only the current
+// and previous sessions are kept.
+func (p *Plugin) recordHistory(session string, tuBytes []byte) {
+	for s := range p.history.Sessions {
+		if s != p.session.GetCurrent() && s != p.session.GetPrevious() {
+			delete(p.history.Sessions, s)
+		}
+	}
+	sh, present := p.history.Sessions[session]
+	if !present {
+		sh = &SessionHistory{}
+		p.history.Sessions[session] = sh
+	}
+	sh.TrackUpdates = append(sh.TrackUpdates, tuBytes)
+	if dropped := len(sh.TrackUpdates) - historyMaxUpdates; dropped > 0 {
+		sh.TrackUpdates = sh.TrackUpdates[dropped:]
+	}
+	p.writeHistory()
+}
+
+func (p *Plugin) handleCommandReconcile(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req ReconcileRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	session := p.session.GetCurrent()
+	if session == "" {
+		reply.Error = core.NotFoundError()
+		userMessage := "There's no current session to reconcile"
+		reply.Error.UserMessage = &userMessage
+		return reply
+	}
+	tokenIDs := []string{req.GetTokenId()}
+	if req.GetTokenId() == "" {
+		tokenIDs = tokenIDs[:0]
+		for tokenID, tCfg := range p.cfg.GetTokens() {
+			if tCfg.GetEnabled() {
+				tokenIDs = append(tokenIDs, tokenID)
+			}
+		}
+	}
+
+	resp := &ReconcileResponse{
+		Results: map[string]*ReconcileResult{},
+	}
+	changed := false
+	for _, tokenID := range tokenIDs {
+		tCfg, present := p.cfg.Tokens[tokenID]
+		if !present {
+			reply.Error = core.NotFoundError()
+			return reply
+		}
+		result := &ReconcileResult{}
+		resp.Results[tokenID] = result
+		missing, err := p.reconcile(tokenID, tCfg, session)
+		if err != nil {
+			core.LogError("reconciling", "token_id", tokenID, "session", session, "error", err.Error())
+			result.Error = err.Error()
+			continue
+		}
+		result.Missing = int32(len(missing))
+		for _, tuBytes := range missing {
+			p.enqueue(tokenID, &QueuedUpdate{
+				TrackUpdate: tuBytes,
+				Session:     session,
+			})
+			changed = true
+		}
+	}
+	if changed {
+		p.writeOutboxes()
+		sendQueueEvent()
+		if now, _, err := svc.CurrentTimeMillis(); err == nil {
+			p.drainAll(now, true)
+		}
+	}
+	core.MarshalMessage(reply, resp)
+	return reply
+}
+
+// reconcile returns the updates in session's history that tokenID's server
+// doesn't have and that aren't already waiting to be sent
+func (p *Plugin) reconcile(tokenID string, tCfg *TokenConfig, session string) ([][]byte, error) {
+	have, err := fetchIndexes(tCfg.GetToken(), session)
+	if err != nil {
+		return nil, err
+	}
+	for _, qu := range p.outboxes.GetOutboxes()[tokenID].GetUpdates() {
+		if qu.GetSession() != session {
+			continue
+		}
+		if index, err := getIndex(qu.GetTrackUpdate()); err == nil {
+			have[index] = true
+		}
+	}
+	var missing [][]byte
+	for _, tuBytes := range p.history.GetSessions()[session].GetTrackUpdates() {
+		index, err := getIndex(tuBytes)
+		if err != nil {
+			return nil, err
+		}
+		if !have[index] {
+			missing = append(missing, tuBytes)
+		}
+	}
+	return missing, nil
+}
+
+func getIndex(tuBytes []byte) (int32, error) {
+	var itu IndexedTrackUpdate
+	if err := itu.UnmarshalVT(tuBytes); err != nil {
+		return 0, fmt.Errorf("unmarshalling track update index: %w", err)
+	}
+	return itu.GetIndex(), nil
+}
+
+// fetchIndexes gets the indexes of the updates token's server has for session
+func fetchIndexes(token *Token, session string) (map[int32]bool, error) {
+	u, err := url.Parse(token.GetIssuer())
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer URL: %w", err)
+	}
+	u.Path = path.Join(u.Path,
+		"_trackUpdate",
+		token.GetSubject(),
+		session,
+	)
+	resp, err := svc.WebclientRequest(&svc.WebclientHTTPRequest{
+		Request: &svc.HTTPRequest{
+			Method: "GET",
+			Url:    u.String(),
+			Header: map[string]*svc.StringValues{
+				"x-extension-jwt": {Values: []string{token.GetRawToken()}},
+			},
+		},
+	}, sendTimeoutMS)
+	if err != nil {
+		return nil, fmt.Errorf("sending HTTP request: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("non-200 status: %s", resp.GetStatus())
+	}
+	var serverSession struct {
+		Updates []struct {
+			Index int32 `json:"index"`
+		} `json:"updates"`
+	}
+	if err := json.Unmarshal(resp.GetBody().GetInline(), &serverSession); err != nil {
+		return nil, fmt.Errorf("parsing session: %w", err)
+	}
+	have := map[int32]bool{}
+	for _, update := range serverSession.Updates {
+		have[update.Index] = true
+	}
+	return have, nil
+}
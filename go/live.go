@@ -3,7 +3,6 @@ package live
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/autonomouskoi/akcore"
 	"github.com/autonomouskoi/core-tinygo"
@@ -16,9 +15,12 @@ var (
 )
 
 type Plugin struct {
-	cfg     *Config
-	router  core.TopicRouter
-	session string
+	cfg      *Config
+	outboxes *Outboxes
+	router   core.TopicRouter
+	session  *SessionState
+	rules    []compiledRule
+	history  *History
 }
 
 func New() (*Plugin, error) {
@@ -27,12 +29,18 @@ func New() (*Plugin, error) {
 	if err := p.loadConfig(); err != nil {
 		return nil, fmt.Errorf("loading config: %w", err)
 	}
-
-	session, _, err := svc.CurrentTimeMillis()
-	if err != nil {
-		return nil, fmt.Errorf("getting current time: %w", err)
+	if err := p.loadOutboxes(); err != nil {
+		return nil, fmt.Errorf("loading outboxes: %w", err)
+	}
+	if err := p.migrateTokenIDs(); err != nil {
+		return nil, fmt.Errorf("migrating tokens: %w", err)
+	}
+	if err := p.loadSession(); err != nil {
+		return nil, fmt.Errorf("loading session: %w", err)
+	}
+	if err := p.loadHistory(); err != nil {
+		return nil, fmt.Errorf("loading history: %w", err)
 	}
-	p.session = strconv.FormatInt(session, 10)
 
 	p.router = core.TopicRouter{
 		trackstar.BusTopic_TRACKSTAR_EVENT.String(): p.handleTrackstarEvent(),
@@ -48,6 +56,11 @@ func New() (*Plugin, error) {
 		}
 	}
 
+	// retry anything left over and anything that fails later
+	if _, err := svc.TimeNotifyEvery(outboxTickMS); err != nil {
+		return nil, fmt.Errorf("starting outbox timer: %w", err)
+	}
+
 	return p, nil
 }
 
@@ -56,7 +69,9 @@ func (p *Plugin) Handle(msg *core.BusMessage) {
 }
 
 func (p *Plugin) handleDirect() core.TypeRouter {
-	return core.TypeRouter{}
+	return core.TypeRouter{
+		int32(svc.MessageType_TIME_NOTIFICATION_EVENT): p.handleTimeNotification,
+	}
 }
 
 func (p *Plugin) loadConfig() error {
@@ -66,6 +81,12 @@ func (p *Plugin) loadConfig() error {
 	if err := core.KVGetProto(cfgKVKey, p.cfg); err != nil && !errors.Is(err, akcore.ErrNotFound) {
 		return fmt.Errorf("retrieving config: %w", err)
 	}
+	rules, err := compileRules(p.cfg.GetRules())
+	if err != nil {
+		// rules are checked when set, this shouldn't happen
+		core.LogError("invalid rules, ignoring them", "error", err.Error())
+	}
+	p.rules = rules
 	return nil
 }
 
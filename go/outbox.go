@@ -0,0 +1,273 @@
+package live
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/autonomouskoi/akcore"
+	"github.com/autonomouskoi/core-tinygo"
+	"github.com/autonomouskoi/core-tinygo/svc"
+	trackstar "github.com/autonomouskoi/trackstar-tinygo"
+)
+
+var (
+	outboxKVKey = []byte("outbox")
+)
+
+const (
+	// outboxMaxUpdates caps how many updates are held for one token. Beyond
+	// this the oldest are dropped.
+	outboxMaxUpdates = 500
+	outboxRetryMinMS = 1000
+	outboxRetryMaxMS = 5 * 60 * 1000
+	// outboxTickMS is how often we check for updates due to be retried
+	outboxTickMS = 5000
+
+	sendTimeoutMS = 5000
+	// sendTimeoutFailingMS is used for destinations that are failing, so a
+	// dead server doesn't tie up the plugin for long
+	sendTimeoutFailingMS = 2000
+	// drainBudgetMS is about how long one token may spend sending per drain,
+	// so a long backlog for one destination doesn't starve the others
+	drainBudgetMS = 10000
+)
+
+func (p *Plugin) loadOutboxes() error {
+	p.outboxes = &Outboxes{
+		Outboxes: map[string]*Outbox{},
+	}
+	if err := core.KVGetProto(outboxKVKey, p.outboxes); err != nil && !errors.Is(err, akcore.ErrNotFound) {
+		return fmt.Errorf("retrieving outboxes: %w", err)
+	}
+	if p.outboxes.Outboxes == nil {
+		p.outboxes.Outboxes = map[string]*Outbox{}
+	}
+	return nil
+}
+
+func (p *Plugin) writeOutboxes() {
+	if err := core.KVSetProto(outboxKVKey, p.outboxes); err != nil {
+		core.LogError("writing outboxes", "error", err.Error())
+	}
+}
+
+// enqueue adds an update to the end of tokenID's outbox
+func (p *Plugin) enqueue(tokenID string, qu *QueuedUpdate) {
+	ob, present := p.outboxes.Outboxes[tokenID]
+	if !present {
+		ob = &Outbox{}
+		p.outboxes.Outboxes[tokenID] = ob
+	}
+	ob.Updates = append(ob.Updates, qu)
+	if dropped := len(ob.Updates) - outboxMaxUpdates; dropped > 0 {
+		core.LogError("outbox full, dropping oldest updates", "token_id", tokenID, "dropped", dropped)
+		ob.Updates = ob.Updates[dropped:]
+		if ob.Health == nil {
+			ob.Health = &DestinationHealth{}
+		}
+		ob.Health.TotalFailed += int64(dropped)
+	}
+}
+
+// released reports whether qu may be published at now
+func released(qu *QueuedUpdate, now int64) bool {
+	return !qu.GetHeldForNext() && now >= qu.GetReleaseAt()
+}
+
+// releaseHeld allows updates in tokenID's outbox held until the next track to be
+// published. It reports whether any were held.
+func (p *Plugin) releaseHeld(tokenID string) bool {
+	changed := false
+	for _, qu := range p.outboxes.GetOutboxes()[tokenID].GetUpdates() {
+		if qu.HeldForNext {
+			qu.HeldForNext = false
+			changed = true
+		}
+	}
+	return changed
+}
+
+func sendQueueEvent() {
+	msg := &core.BusMessage{
+		Topic: BusTopic_TRACKSTAR_LIVE_EVENT.String(),
+		Type:  int32(MessageTypeEvent_QUEUE_EVENT),
+	}
+	if err := core.Send(msg); err != nil {
+		core.LogError("sending queue event", "error", err.Error())
+	}
+}
+
+// drainAll sends whatever's waiting for every enabled token that isn't
+// backing off. Sends are synchronous and the plugin makes one at a time, so
+// tokens take turns sending one update each, healthy destinations first. Each
+// token stops when its outbox is empty, a send fails or it's used up
+// drainBudgetMS, leaving the rest for the next tick. A failing destination is
+// only retried on the timer, never inline, so it can't hold up delivery
+// elsewhere.
+func (p *Plugin) drainAll(now int64, inline bool) {
+	changed := false
+	tokenIDs := make([]string, 0, len(p.outboxes.Outboxes))
+	for tokenID := range p.outboxes.Outboxes {
+		if _, present := p.cfg.Tokens[tokenID]; !present {
+			// the token was deleted, nowhere to send these
+			delete(p.outboxes.Outboxes, tokenID)
+			changed = true
+			continue
+		}
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+	slices.SortFunc(tokenIDs, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(
+				p.outboxes.Outboxes[a].GetHealth().GetConsecutiveFailures(),
+				p.outboxes.Outboxes[b].GetHealth().GetConsecutiveFailures(),
+			),
+			cmp.Compare(a, b),
+		)
+	})
+
+	tokenIDs = slices.DeleteFunc(tokenIDs, func(tokenID string) bool {
+		ob := p.outboxes.Outboxes[tokenID]
+		if !p.cfg.Tokens[tokenID].GetEnabled() || len(ob.Updates) == 0 || now < ob.NextAttempt || !released(ob.Updates[0], now) {
+			return true
+		}
+		return inline && ob.GetHealth().GetConsecutiveFailures() > 0
+	})
+	for _, tokenID := range tokenIDs {
+		ob := p.outboxes.Outboxes[tokenID]
+		if ob.Health == nil {
+			ob.Health = &DestinationHealth{}
+		}
+		p.refreshToken(p.cfg.Tokens[tokenID], now)
+		changed = true
+	}
+
+	spentMS := map[string]int64{}
+	for active := slices.Clone(tokenIDs); len(active) > 0; {
+		next := active[:0]
+		for _, tokenID := range active {
+			start := clockMS(now)
+			more := p.sendNext(tokenID, p.cfg.Tokens[tokenID], p.outboxes.Outboxes[tokenID], now)
+			spentMS[tokenID] += clockMS(start) - start
+			if more && spentMS[tokenID] < drainBudgetMS {
+				next = append(next, tokenID)
+			}
+		}
+		active = next
+	}
+
+	for _, tokenID := range tokenIDs {
+		sendHealthEvent(tokenID, p.outboxes.Outboxes[tokenID].Health)
+	}
+	if changed {
+		p.writeOutboxes()
+	}
+}
+
+// clockMS gets the current time, or fallback if that fails
+func clockMS(fallback int64) int64 {
+	if now, _, err := svc.CurrentTimeMillis(); err == nil {
+		return now
+	}
+	return fallback
+}
+
+// sendNext sends the first update in ob, reporting whether there may be more
+// to send. It stops at the first update that fails so it can be retried later
+// without reordering, or that isn't released yet.
+func (p *Plugin) sendNext(tokenID string, tCfg *TokenConfig, ob *Outbox, now int64) bool {
+	health := ob.Health
+	if len(ob.Updates) == 0 {
+		return false
+	}
+	qu := ob.Updates[0]
+	if !released(qu, now) {
+		return false
+	}
+	var tu trackstar.TrackUpdate
+	if err := tu.UnmarshalVT(qu.GetTrackUpdate()); err != nil {
+		core.LogError("unmarshalling queued update", "token_id", tokenID, "error", err.Error())
+		ob.Updates = ob.Updates[1:]
+		health.TotalFailed++
+		return true
+	}
+	timeoutMS := uint64(sendTimeoutMS)
+	if health.ConsecutiveFailures > 0 {
+		timeoutMS = sendTimeoutFailingMS
+	}
+	retry, err := sendUpdate(tCfg.GetToken(), qu.GetSession(), qu.GetTrackUpdate(), timeoutMS)
+	var throttled *throttledError
+	if errors.As(err, &throttled) {
+		// the destination is fine, it's just busy, so wait as long as it
+		// asks without counting a failure
+		wait := max(retryAfterMS(throttled.retryAfter, now), outboxRetryMinMS)
+		core.LogWarn("track update throttled",
+			"token_id", tokenID,
+			"retry_after_ms", wait,
+		)
+		health.LastError = err.Error()
+		health.LastErrorAt = now
+		ob.NextAttempt = now + wait
+		return false
+	}
+	if err != nil {
+		sendTSLEvent(nil, err)
+		core.LogError("sending track update",
+			"token_id", tokenID,
+			"error", err.Error(),
+			"retry", retry,
+		)
+		health.LastError = err.Error()
+		health.LastErrorAt = now
+		if !retry {
+			// retries aren't failures until we give up on the update
+			ob.Updates = ob.Updates[1:]
+			health.TotalFailed++
+			return true
+		}
+		health.ConsecutiveFailures++
+		ob.NextAttempt = now + backoff(health.ConsecutiveFailures)
+		return false
+	}
+	ob.Updates = ob.Updates[1:]
+	ob.NextAttempt = 0
+	health.ConsecutiveFailures = 0
+	health.LastSuccess = now
+	health.TotalSent++
+	sendTSLEvent(&tu, nil)
+	return true
+}
+
+func sendHealthEvent(tokenID string, health *DestinationHealth) {
+	msg := &core.BusMessage{
+		Topic: BusTopic_TRACKSTAR_LIVE_EVENT.String(),
+		Type:  int32(MessageTypeEvent_DESTINATION_HEALTH_EVENT),
+	}
+	core.MarshalMessage(msg, &DestinationHealthEvent{
+		TokenId: tokenID,
+		Health:  health,
+	})
+	if err := core.Send(msg); err != nil {
+		core.LogError("sending health event", "error", err.Error())
+	}
+}
+
+// backoff doubles the retry delay with each consecutive failure, up to a limit
+func backoff(failures int32) int64 {
+	delay := int64(outboxRetryMinMS)
+	for i := int32(1); i < failures && delay < outboxRetryMaxMS; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryMaxMS)
+}
+
+func (p *Plugin) handleTimeNotification(msg *core.BusMessage) *core.BusMessage {
+	var tn svc.TimeNotification
+	if err := core.UnmarshalMessage(msg, &tn); err != nil {
+		return nil
+	}
+	p.drainAll(tn.GetCurrentTimeMillis(), false)
+	return nil
+}
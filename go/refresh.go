@@ -0,0 +1,67 @@
+package live
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/autonomouskoi/core-tinygo"
+	"github.com/autonomouskoi/core-tinygo/svc"
+)
+
+// refreshToken exchanges tCfg's token for a new one once more than half of its
+// lifetime has passed. If refreshing fails the current token stays in use.
+func (p *Plugin) refreshToken(tCfg *TokenConfig, now int64) {
+	token := tCfg.GetToken()
+	lifetime := token.GetExpiresAt() - token.GetIssuedAt()
+	if now < token.GetIssuedAt()+lifetime/2 || now >= token.GetExpiresAt() {
+		return
+	}
+	newToken, err := requestRefresh(token)
+	if err != nil {
+		core.LogError("refreshing token",
+			"issuer", token.GetIssuer(),
+			"subject", token.GetSubject(),
+			"error", err.Error(),
+		)
+		return
+	}
+	core.LogInfo("refreshed token",
+		"issuer", newToken.GetIssuer(),
+		"subject", newToken.GetSubject(),
+		"expires_at", newToken.GetExpiresAt(),
+	)
+	tCfg.Token = newToken
+	p.writeCfg()
+}
+
+func requestRefresh(token *Token) (*Token, error) {
+	u, err := url.Parse(token.GetIssuer())
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer URL: %w", err)
+	}
+	u.Path = path.Join(u.Path, "_refresh")
+	resp, err := svc.WebclientRequest(&svc.WebclientHTTPRequest{
+		Request: &svc.HTTPRequest{
+			Method: "POST",
+			Url:    u.String(),
+			Header: map[string]*svc.StringValues{
+				"x-extension-jwt": {Values: []string{token.GetRawToken()}},
+			},
+		},
+	}, 5000)
+	if err != nil {
+		return nil, fmt.Errorf("sending HTTP request: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("non-200 status: %s", resp.GetStatus())
+	}
+	newToken := &Token{}
+	if err := newToken.UnmarshalVT(resp.GetBody().GetInline()); err != nil {
+		return nil, fmt.Errorf("unmarshalling token: %w", err)
+	}
+	if newToken.GetRawToken() == "" || newToken.GetSubject() != token.GetSubject() {
+		return nil, fmt.Errorf("server returned an unusable token")
+	}
+	return newToken, nil
+}
@@ -1,23 +1,87 @@
 package live
 
-import "github.com/autonomouskoi/core-tinygo"
+import (
+	"maps"
+	"slices"
+
+	"github.com/autonomouskoi/core-tinygo"
+)
 
 func (p *Plugin) handleRequest() core.TypeRouter {
 	return core.TypeRouter{
-		int32(MessageTypeRequest_CONFIG_GET_REQ): p.handleRequestGetConfig,
+		int32(MessageTypeRequest_CONFIG_GET_REQ):  p.handleRequestGetConfig,
+		int32(MessageTypeRequest_QUEUE_GET_REQ):   p.handleRequestGetQueue,
+		int32(MessageTypeRequest_STATUS_GET_REQ):  p.handleRequestGetStatus,
+		int32(MessageTypeRequest_SESSION_GET_REQ): p.handleRequestGetSession,
+		int32(MessageTypeRequest_PENDING_GET_REQ): p.handleRequestGetPending,
 	}
 }
 
 func (p *Plugin) handleRequestGetConfig(msg *core.BusMessage) *core.BusMessage {
 	reply := core.DefaultReply(msg)
+	core.MarshalMessage(reply, &GetConfigResponse{
+		Config: p.publicConfig(),
+	})
+	return reply
+}
+
+// publicConfig returns a copy of the config without the raw tokens
+func (p *Plugin) publicConfig() *Config {
 	cfg := p.cfg.CloneVT()
 	for _, tCfg := range cfg.Tokens {
 		if tCfg.Token != nil {
 			tCfg.Token.RawToken = ""
 		}
 	}
-	core.MarshalMessage(reply, &GetConfigResponse{
-		Config: cfg,
-	})
+	return cfg
+}
+
+func (p *Plugin) handleRequestGetQueue(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	resp := &GetQueueResponse{
+		Queues: map[string]*QueueStatus{},
+	}
+	for tokenID, ob := range p.outboxes.GetOutboxes() {
+		resp.Queues[tokenID] = &QueueStatus{
+			Depth:       int32(len(ob.GetUpdates())),
+			NextAttempt: ob.GetNextAttempt(),
+			Health:      ob.GetHealth(),
+		}
+	}
+	core.MarshalMessage(reply, resp)
+	return reply
+}
+
+// handleRequestGetPending lists every update waiting to be published, in the
+// order each token will send them
+func (p *Plugin) handleRequestGetPending(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	tokenIDs := slices.Sorted(maps.Keys(p.outboxes.GetOutboxes()))
+	resp := &GetPendingResponse{}
+	for _, tokenID := range tokenIDs {
+		for _, qu := range p.outboxes.Outboxes[tokenID].GetUpdates() {
+			resp.Pending = append(resp.Pending, &PendingUpdate{
+				TokenId: tokenID,
+				Update:  qu,
+			})
+		}
+	}
+	core.MarshalMessage(reply, resp)
+	return reply
+}
+
+func (p *Plugin) handleRequestGetStatus(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	resp := &GetStatusResponse{
+		Destinations: map[string]*DestinationHealth{},
+	}
+	for tokenID := range p.cfg.GetTokens() {
+		health := p.outboxes.GetOutboxes()[tokenID].GetHealth()
+		if health == nil {
+			health = &DestinationHealth{}
+		}
+		resp.Destinations[tokenID] = health
+	}
+	core.MarshalMessage(reply, resp)
 	return reply
 }
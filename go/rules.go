@@ -0,0 +1,141 @@
+package live
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/autonomouskoi/core-tinygo"
+	trackstar "github.com/autonomouskoi/trackstar-tinygo"
+)
+
+type compiledRule struct {
+	rule   *Rule
+	artist *regexp.Regexp
+	title  *regexp.Regexp
+}
+
+// compileRules checks rules and compiles their patterns
+func compileRules(rules []*Rule) ([]compiledRule, error) {
+	compiled := make([]compiledRule, 0, len(rules))
+	for i, rule := range rules {
+		if rule.GetDeckId() == "" && rule.GetArtistPattern() == "" && rule.GetTitlePattern() == "" {
+			return nil, fmt.Errorf("rule %d: no deck, artist or title to match", i+1)
+		}
+		if rule.GetAction() == RuleAction_RULE_ACTION_REWRITE &&
+			rule.GetRewriteArtist() == "" && rule.GetRewriteTitle() == "" {
+			return nil, fmt.Errorf("rule %d: no artist or title to rewrite with", i+1)
+		}
+		cr := compiledRule{rule: rule}
+		var err error
+		if pattern := rule.GetArtistPattern(); pattern != "" {
+			if cr.artist, err = regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("rule %d: artist pattern: %w", i+1, err)
+			}
+		}
+		if pattern := rule.GetTitlePattern(); pattern != "" {
+			if cr.title, err = regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("rule %d: title pattern: %w", i+1, err)
+			}
+		}
+		compiled = append(compiled, cr)
+	}
+	return compiled, nil
+}
+
+func (cr compiledRule) matches(tu *trackstar.TrackUpdate) bool {
+	if deckID := cr.rule.GetDeckId(); deckID != "" && deckID != tu.GetDeckId() {
+		return false
+	}
+	if cr.artist != nil && !cr.artist.MatchString(tu.GetTrack().GetArtist()) {
+		return false
+	}
+	if cr.title != nil && !cr.title.MatchString(tu.GetTrack().GetTitle()) {
+		return false
+	}
+	return true
+}
+
+// applyRules runs tu through the configured rules and deck names. If the
+// update should not be published, false is returned. Otherwise it reports
+// whether tu was modified.
+func (p *Plugin) applyRules(tu *trackstar.TrackUpdate) (publish, modified bool) {
+	if p.cfg.HideNext {
+		core.LogInfo("hiding track", "artist", tu.GetTrack().GetArtist(), "title", tu.GetTrack().GetTitle())
+		p.cfg.HideNext = false
+		p.writeCfg()
+		return false, false
+	}
+	for i, cr := range p.rules {
+		if !cr.matches(tu) {
+			continue
+		}
+		if cr.rule.GetAction() == RuleAction_RULE_ACTION_SKIP {
+			core.LogDebug("skipping track", "rule", i+1, "deck_id", tu.GetDeckId())
+			return false, false
+		}
+		if tu.Track == nil {
+			tu.Track = &trackstar.Track{}
+		}
+		if artist := cr.rule.GetRewriteArtist(); artist != "" {
+			tu.Track.Artist = artist
+		}
+		if title := cr.rule.GetRewriteTitle(); title != "" {
+			tu.Track.Title = title
+		}
+		modified = true
+	}
+	// rules match on the original deck ID, so it's renamed last
+	if name, present := p.cfg.GetDeckNames()[tu.GetDeckId()]; present && name != "" {
+		tu.DeckId = name
+		modified = true
+	}
+	return true, modified
+}
+
+// handleCommandSetConfig sets the rules, deck names and session idle time. Tokens are managed with
+// TOKEN_SET_REQ, so any in the request are ignored.
+func (p *Plugin) handleCommandSetConfig(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req SetConfigRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	rules, err := compileRules(req.GetConfig().GetRules())
+	if err != nil {
+		reply.Error = core.InvalidTypeError(err)
+		userMessage := err.Error()
+		reply.Error.UserMessage = &userMessage
+		return reply
+	}
+	if req.GetConfig().GetSessionIdleMinutes() < 0 {
+		reply.Error = core.InvalidTypeError(fmt.Errorf("negative session idle minutes: %d", req.GetConfig().GetSessionIdleMinutes()))
+		userMessage := "Session idle minutes can't be negative"
+		reply.Error.UserMessage = &userMessage
+		return reply
+	}
+	p.rules = rules
+	p.cfg.Rules = req.GetConfig().GetRules()
+	p.cfg.DeckNames = req.GetConfig().GetDeckNames()
+	p.cfg.HideNext = req.GetConfig().GetHideNext()
+	p.cfg.SessionIdleMinutes = req.GetConfig().GetSessionIdleMinutes()
+	p.writeCfg()
+	core.MarshalMessage(reply, &SetConfigResponse{
+		Config: p.publicConfig(),
+	})
+	return reply
+}
+
+// handleCommandHideNext sets or clears hiding the next track
+func (p *Plugin) handleCommandHideNext(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req HideNextRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	p.cfg.HideNext = req.GetHide()
+	p.writeCfg()
+	core.MarshalMessage(reply, &HideNextResponse{
+		Hide: p.cfg.HideNext,
+	})
+	return reply
+}
@@ -0,0 +1,187 @@
+package live
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/autonomouskoi/akcore"
+	"github.com/autonomouskoi/core-tinygo"
+	"github.com/autonomouskoi/core-tinygo/svc"
+)
+
+var (
+	sessionKVKey = []byte("session")
+)
+
+// defaultSessionIdleMinutes is used when the config doesn't set
+// session_idle_minutes
+const defaultSessionIdleMinutes = 4 * 60
+
+func (p *Plugin) loadSession() error {
+	p.session = &SessionState{}
+	if err := core.KVGetProto(sessionKVKey, p.session); err != nil && !errors.Is(err, akcore.ErrNotFound) {
+		return fmt.Errorf("retrieving session: %w", err)
+	}
+	return nil
+}
+
+func (p *Plugin) writeSession() {
+	if err := core.KVSetProto(sessionKVKey, p.session); err != nil {
+		core.LogError("writing session", "error", err.Error())
+	}
+}
+
+// sessionChanged persists the session state and lets the UI know about it
+func (p *Plugin) sessionChanged() {
+	p.writeSession()
+	msg := &core.BusMessage{
+		Topic: BusTopic_TRACKSTAR_LIVE_EVENT.String(),
+		Type:  int32(MessageTypeEvent_SESSION_EVENT),
+	}
+	core.MarshalMessage(msg, p.session)
+	if err := core.Send(msg); err != nil {
+		core.LogError("sending session event", "error", err.Error())
+	}
+}
+
+// startSession begins a new session identified by the current time. The
+// current session, if any, becomes the previous one.
+func (p *Plugin) startSession() error {
+	now, _, err := svc.CurrentTimeMillis()
+	if err != nil {
+		return fmt.Errorf("getting current time: %w", err)
+	}
+	if p.session.Current != "" {
+		p.session.Previous = p.session.Current
+		p.session.PreviousLastIndex = p.session.LastIndex
+	}
+	p.session.Current = strconv.FormatInt(now, 10)
+	p.session.LastIndex = 0
+	p.session.LastTrackAt = now
+	core.LogInfo("starting session", "session", p.session.Current)
+	p.sessionChanged()
+	return nil
+}
+
+// currentSession returns the session to send updates to at now, starting one
+// if none is active or the current one has gone idle
+func (p *Plugin) currentSession(now int64) (string, error) {
+	if p.session.Current != "" && p.sessionIdle(now) {
+		core.LogInfo("session idle, starting a new one",
+			"session", p.session.Current,
+			"last_track_at", p.session.LastTrackAt,
+		)
+		p.session.Current = ""
+	}
+	if p.session.Current == "" {
+		if err := p.startSession(); err != nil {
+			return "", err
+		}
+	}
+	return p.session.Current, nil
+}
+
+// sessionIdle reports whether the current session has gone without a track
+// for longer than the configured idle time, e.g. because the plugin was
+// restarted for a later set
+func (p *Plugin) sessionIdle(now int64) bool {
+	idleMinutes := p.cfg.GetSessionIdleMinutes()
+	if idleMinutes <= 0 {
+		idleMinutes = defaultSessionIdleMinutes
+	}
+	last := p.session.LastTrackAt
+	if last == 0 {
+		// saved before we tracked this, the session ID is when it started
+		last, _ = strconv.ParseInt(p.session.Current, 10, 64)
+	}
+	return now-last > idleMinutes*60*1000
+}
+
+func (p *Plugin) handleCommandSessionStart(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req SessionStartRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	if err := p.startSession(); err != nil {
+		reply.Error = core.BusError(err)
+		return reply
+	}
+	core.MarshalMessage(reply, &SessionStartResponse{
+		Session: p.session,
+	})
+	return reply
+}
+
+// handleCommandSessionEnd ends the current session. The next track starts a
+// new one.
+func (p *Plugin) handleCommandSessionEnd(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req SessionEndRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	if p.session.Current != "" {
+		core.LogInfo("ending session", "session", p.session.Current)
+		p.session.Previous = p.session.Current
+		p.session.PreviousLastIndex = p.session.LastIndex
+		p.session.Current = ""
+		p.session.LastIndex = 0
+		p.session.LastTrackAt = 0
+		p.sessionChanged()
+		// there won't be a next track in this session to release held ones
+		released := false
+		for id := range p.outboxes.GetOutboxes() {
+			released = p.releaseHeld(id) || released
+		}
+		if released {
+			p.writeOutboxes()
+			sendQueueEvent()
+		}
+	}
+	core.MarshalMessage(reply, &SessionEndResponse{
+		Session: p.session,
+	})
+	return reply
+}
+
+// handleCommandSessionResume makes the previous session current again, e.g.
+// after a session was ended or started by mistake
+func (p *Plugin) handleCommandSessionResume(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	var req SessionResumeRequest
+	if reply.Error = core.UnmarshalMessage(msg, &req); reply.Error != nil {
+		return reply
+	}
+	if p.session.Previous == "" {
+		reply.Error = core.NotFoundError()
+		userMessage := "There's no previous session to resume"
+		reply.Error.UserMessage = &userMessage
+		return reply
+	}
+	now, _, err := svc.CurrentTimeMillis()
+	if err != nil {
+		reply.Error = core.BusError(err)
+		return reply
+	}
+	core.LogInfo("resuming session", "session", p.session.Previous)
+	p.session.Current, p.session.Previous = p.session.Previous, p.session.Current
+	p.session.LastIndex, p.session.PreviousLastIndex = p.session.PreviousLastIndex, p.session.LastIndex
+	// resuming counts as activity, or an old session would be ended again by
+	// the next track
+	p.session.LastTrackAt = now
+	p.sessionChanged()
+	core.MarshalMessage(reply, &SessionResumeResponse{
+		Session: p.session,
+	})
+	return reply
+}
+
+func (p *Plugin) handleRequestGetSession(msg *core.BusMessage) *core.BusMessage {
+	reply := core.DefaultReply(msg)
+	core.MarshalMessage(reply, &GetSessionResponse{
+		Session: p.session,
+	})
+	return reply
+}
@@ -0,0 +1,60 @@
+package live
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+)
+
+// newTokenID generates an ID to store a token under. It's stable across
+// renames and unique even if one user has several tokens for a server.
+func newTokenID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating token ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// viewerURL is where the sets sent with t can be viewed. It's the default label
+// for a token.
+func viewerURL(t *Token) (string, error) {
+	u, err := url.Parse(t.GetIssuer())
+	if err != nil {
+		return "", fmt.Errorf("parsing issuer URL: %w", err)
+	}
+	u.Path = "/u/" + t.GetSubject()
+	return u.String(), nil
+}
+
+// migrateTokenIDs moves tokens from configs that keyed them by viewer URL to
+// generated IDs, keeping the URL as the label. Their outboxes move with them.
+func (p *Plugin) migrateTokenIDs() error {
+	migrated := map[string]string{}
+	for oldKey, tCfg := range p.cfg.Tokens {
+		if tCfg.GetLabel() != "" {
+			continue
+		}
+		tokenID, err := newTokenID()
+		if err != nil {
+			return err
+		}
+		tCfg.Label = oldKey
+		migrated[oldKey] = tokenID
+	}
+	if len(migrated) == 0 {
+		return nil
+	}
+	for oldKey, tokenID := range migrated {
+		p.cfg.Tokens[tokenID] = p.cfg.Tokens[oldKey]
+		delete(p.cfg.Tokens, oldKey)
+		if ob, present := p.outboxes.Outboxes[oldKey]; present {
+			p.outboxes.Outboxes[tokenID] = ob
+			delete(p.outboxes.Outboxes, oldKey)
+		}
+	}
+	p.writeCfg()
+	p.writeOutboxes()
+	return nil
+}
@@ -0,0 +1,124 @@
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/autonomouskoi/core-tinygo"
+	"github.com/autonomouskoi/core-tinygo/svc"
+)
+
+func tokenError(code TokenErrorCode, userMessage string, detail error) *core.Error {
+	return &core.Error{
+		Code:           int32(code),
+		Detail:         core.String(detail.Error()),
+		UserMessage:    core.String(userMessage),
+		NotCommonError: true,
+	}
+}
+
+// validateToken checks that t can be used before it's saved, so a bad token is
+// found when it's imported rather than when a track fails to send
+func validateToken(t *Token, allowInsecure, verify bool, now int64) *core.Error {
+	if t.GetRawToken() == "" || t.GetSubject() == "" {
+		return tokenError(TokenErrorCode_TOKEN_ERROR_INVALID,
+			"This isn't a complete token",
+			fmt.Errorf("missing raw token or subject"),
+		)
+	}
+	if expires := t.GetExpiresAt(); expires != 0 && expires <= now {
+		return tokenError(TokenErrorCode_TOKEN_ERROR_EXPIRED,
+			"This token has expired. Ask the site operator for a new one",
+			fmt.Errorf("expired at %d", expires),
+		)
+	}
+	u, err := url.Parse(t.GetIssuer())
+	if err != nil || u.Host == "" {
+		if err == nil {
+			err = fmt.Errorf("no host in issuer %q", t.GetIssuer())
+		}
+		return tokenError(TokenErrorCode_TOKEN_ERROR_INVALID,
+			"The token's server address isn't valid",
+			err,
+		)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && allowInsecure:
+	default:
+		return tokenError(TokenErrorCode_TOKEN_ERROR_INSECURE,
+			"The token's server doesn't use https. Allow insecure servers to use it anyway",
+			fmt.Errorf("issuer scheme %q", u.Scheme),
+		)
+	}
+	if !verify {
+		return nil
+	}
+	return whoami(t)
+}
+
+// whoami asks t's server to verify it. Servers without the whoami endpoint are
+// assumed to accept it.
+func whoami(t *Token) *core.Error {
+	u, err := url.Parse(t.GetIssuer())
+	if err != nil {
+		return tokenError(TokenErrorCode_TOKEN_ERROR_INVALID, "The token's server address isn't valid", err)
+	}
+	u.Path = path.Join(u.Path, "_whoami")
+	resp, err := svc.WebclientRequest(&svc.WebclientHTTPRequest{
+		Request: &svc.HTTPRequest{
+			Method: "GET",
+			Url:    u.String(),
+			Header: map[string]*svc.StringValues{
+				"x-extension-jwt": {Values: []string{t.GetRawToken()}},
+			},
+		},
+	}, sendTimeoutMS)
+	if err != nil {
+		return tokenError(TokenErrorCode_TOKEN_ERROR_UNREACHABLE,
+			"Couldn't reach the token's server. Check the address, or skip verifying",
+			err,
+		)
+	}
+	switch resp.StatusCode {
+	case 200:
+	case 404:
+		core.LogDebug("server has no whoami, not verifying token", "issuer", t.GetIssuer())
+		return nil
+	case 401, 403:
+		return tokenError(TokenErrorCode_TOKEN_ERROR_REJECTED,
+			"The server rejected this token. It may have been revoked",
+			fmt.Errorf("status: %s", resp.GetStatus()),
+		)
+	default:
+		return tokenError(TokenErrorCode_TOKEN_ERROR_UNREACHABLE,
+			"The token's server had a problem. Try again later, or skip verifying",
+			fmt.Errorf("status: %s", resp.GetStatus()),
+		)
+	}
+	var who struct {
+		Subject string `json:"subject"`
+		Revoked bool   `json:"revoked"`
+	}
+	if err := json.Unmarshal(resp.GetBody().GetInline(), &who); err != nil {
+		return tokenError(TokenErrorCode_TOKEN_ERROR_UNREACHABLE,
+			"The token's server sent an unexpected reply",
+			fmt.Errorf("parsing whoami: %w", err),
+		)
+	}
+	if who.Revoked {
+		return tokenError(TokenErrorCode_TOKEN_ERROR_REJECTED,
+			"This token has been revoked. Ask the site operator for a new one",
+			fmt.Errorf("revoked"),
+		)
+	}
+	if who.Subject != t.GetSubject() {
+		return tokenError(TokenErrorCode_TOKEN_ERROR_WRONG_SUBJECT,
+			"The server says this token belongs to someone else",
+			fmt.Errorf("token subject %q, server subject %q", t.GetSubject(), who.Subject),
+		)
+	}
+	return nil
+}
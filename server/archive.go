@@ -0,0 +1,169 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/autonomouskoi/trackstar-live/server/archive"
+	"github.com/autonomouskoi/trackstar-live/server/store"
+)
+
+// archiveMaxBytes is the largest archive that can be imported
+const archiveMaxBytes = 64 << 20
+
+// archiveExport streams all of the user's sets as an archive
+func (srv *Server) archiveExport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := srv.authenticateOwner(w, r, "archive")
+	if !ok {
+		return
+	}
+	sessions, err := srv.store.SessionsList(r.Context(), userID)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("listing sessions",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"error", err.Error(),
+		)
+		return
+	}
+	unlisted, err := srv.store.SessionsUnlisted(r.Context(), userID)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("listing unlisted sessions",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	now := time.Now()
+	w.Header().Set(headerContentType, archive.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s.jsonl"`, userID, now.Format(time.DateOnly)))
+	w.WriteHeader(http.StatusOK)
+	// the status has been sent, so from here errors can only be logged
+	logErr := func(msg string, started int64, err error) {
+		srv.logger.Error(msg,
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"started", started,
+			"error", err.Error(),
+		)
+	}
+	aw, err := archive.NewWriter(w, userID, now.UnixMilli())
+	if err != nil {
+		logErr("writing archive", 0, err)
+		return
+	}
+	// oldest first, so an import replays sets in order
+	for i := len(sessions) - 1; i >= 0; i-- {
+		started := sessions[i]
+		updates, err := srv.store.SessionGet(r.Context(), userID, started)
+		if err != nil {
+			logErr("getting session", started, err)
+			return
+		}
+		isUnlisted := slices.Contains(unlisted, started)
+		for _, tu := range updates {
+			if err := aw.Write(started, isUnlisted, tu); err != nil {
+				logErr("writing archive", started, err)
+				return
+			}
+		}
+	}
+	srv.logger.Info("exported archive",
+		"remote", r.RemoteAddr,
+		"user_id", userID,
+		"sessions", len(sessions),
+	)
+}
+
+// archiveImport adds the sets in an archive to the user's sets. Updates the
+// user already has are skipped, so importing the same archive again is
+// harmless.
+func (srv *Server) archiveImport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := srv.authenticateOwner(w, r, "archive")
+	if !ok {
+		return
+	}
+	ar, err := archive.NewReader(http.MaxBytesReader(w, r.Body, archiveMaxBytes))
+	if err != nil {
+		http.Error(w, "reading archive: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result := struct {
+		Sessions   int    `json:"sessions"`
+		Imported   int    `json:"imported"`
+		Duplicates int    `json:"duplicates"`
+		Error      string `json:"error,omitempty"`
+	}{}
+	sessions := map[int64]bool{}
+	unlisted := map[int64]bool{}
+	for {
+		record, err := ar.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// what's been imported so far is kept, and reported with the error
+			result.Error = err.Error()
+			break
+		}
+		sessions[record.Started] = true
+		// a set that's unlisted here stays that way, whatever the archive says
+		if record.Unlisted && !unlisted[record.Started] {
+			if err := srv.store.SessionSetUnlisted(r.Context(), userID, record.Started, true); err != nil {
+				defaultHTTPError(w, http.StatusInsufficientStorage)
+				srv.logger.Error("importing unlisted",
+					"remote", r.RemoteAddr,
+					"user_id", userID,
+					"started", record.Started,
+					"error", err.Error(),
+				)
+				return
+			}
+			unlisted[record.Started] = true
+		}
+		err = srv.store.AddTrackUpdate(r.Context(), userID, record.Started, record.TrackUpdate())
+		if errors.Is(err, store.ErrDuplicate) {
+			result.Duplicates++
+			continue
+		}
+		if err != nil {
+			defaultHTTPError(w, http.StatusInsufficientStorage)
+			srv.logger.Error("importing track update",
+				"remote", r.RemoteAddr,
+				"user_id", userID,
+				"started", record.Started,
+				"error", err.Error(),
+			)
+			return
+		}
+		result.Imported++
+	}
+	result.Sessions = len(sessions)
+
+	srv.logger.Info("imported archive",
+		"remote", r.RemoteAddr,
+		"user_id", userID,
+		"archive_user_id", ar.Header.UserID,
+		"sessions", result.Sessions,
+		"imported", result.Imported,
+		"duplicates", result.Duplicates,
+		"error", result.Error,
+	)
+	if result.Error != "" {
+		w.Header().Set(headerContentType, "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+	srv.sendJSON(w, result)
+}
@@ -0,0 +1,159 @@
+// Package archive reads and writes archives of a user's sets, for backing them
+// up or moving them to another server. An archive is JSON Lines: a Header
+// followed by a Record per track update.
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+const (
+	// Format identifies a file as an archive
+	Format = "trackstar-live-archive"
+	// Version is the archive version written. Readers accept this version and
+	// earlier.
+	Version = 1
+
+	// ContentType is the media type of an archive
+	ContentType = "application/x-ndjson"
+
+	// maxLine is the longest line read
+	maxLine = 64 * 1024
+)
+
+var (
+	// ErrNotArchive is returned when reading something that isn't an archive
+	ErrNotArchive = errors.New("not an archive")
+	// ErrUnsupportedVersion is returned when reading an archive newer than
+	// Version
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+)
+
+// Header is the first line of an archive. Times are in milliseconds.
+type Header struct {
+	Format   string `json:"format"`
+	Version  int    `json:"version"`
+	UserID   string `json:"user_id"`
+	Exported int64  `json:"exported"`
+}
+
+// Record is a track update in the set started at Started. Unlisted is set on
+// every record of an unlisted set.
+type Record struct {
+	Started  int64  `json:"started"`
+	Unlisted bool   `json:"unlisted,omitempty"`
+	DeckID   string `json:"deck_id,omitempty"`
+	Artist   string `json:"artist,omitempty"`
+	Title    string `json:"title,omitempty"`
+	When     int64  `json:"when"`
+	Index    int32  `json:"index,omitempty"`
+}
+
+// TrackUpdate is the track update r records
+func (r *Record) TrackUpdate() *trackstar.TrackUpdate {
+	return &trackstar.TrackUpdate{
+		DeckId: r.DeckID,
+		Track: &trackstar.Track{
+			Artist: r.Artist,
+			Title:  r.Title,
+		},
+		When:  r.When,
+		Index: r.Index,
+	}
+}
+
+// Writer writes an archive
+type Writer struct {
+	enc *json.Encoder
+}
+
+// NewWriter writes an archive header for userID to w
+func NewWriter(w io.Writer, userID string, exported int64) (*Writer, error) {
+	aw := &Writer{enc: json.NewEncoder(w)}
+	err := aw.enc.Encode(&Header{
+		Format:   Format,
+		Version:  Version,
+		UserID:   userID,
+		Exported: exported,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("writing header: %w", err)
+	}
+	return aw, nil
+}
+
+// Write writes tu from the set started at started, which is unlisted if
+// unlisted is set
+func (aw *Writer) Write(started int64, unlisted bool, tu *trackstar.TrackUpdate) error {
+	return aw.enc.Encode(&Record{
+		Started:  started,
+		Unlisted: unlisted,
+		DeckID:   tu.GetDeckId(),
+		Artist:   tu.GetTrack().GetArtist(),
+		Title:    tu.GetTrack().GetTitle(),
+		When:     tu.GetWhen(),
+		Index:    tu.GetIndex(),
+	})
+}
+
+// Reader reads an archive
+type Reader struct {
+	Header Header
+
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewReader reads and checks the archive header from r
+func NewReader(r io.Reader) (*Reader, error) {
+	ar := &Reader{scanner: bufio.NewScanner(r)}
+	ar.scanner.Buffer(nil, maxLine)
+	if err := ar.next(&ar.Header); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrNotArchive
+		}
+		return nil, err
+	}
+	switch {
+	case ar.Header.Format != Format:
+		return nil, ErrNotArchive
+	case ar.Header.Version < 1 || ar.Header.Version > Version:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ar.Header.Version)
+	}
+	return ar, nil
+}
+
+// Next reads the next record. At the end of the archive it returns io.EOF.
+func (ar *Reader) Next() (*Record, error) {
+	var record Record
+	if err := ar.next(&record); err != nil {
+		return nil, err
+	}
+	if record.Started == 0 || record.When == 0 {
+		return nil, fmt.Errorf("line %d: missing started or when", ar.line)
+	}
+	return &record, nil
+}
+
+func (ar *Reader) next(v any) error {
+	for ar.scanner.Scan() {
+		ar.line++
+		if len(ar.scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(ar.scanner.Bytes(), v); err != nil {
+			return fmt.Errorf("line %d: %w", ar.line, err)
+		}
+		return nil
+	}
+	if err := ar.scanner.Err(); err != nil {
+		return fmt.Errorf("line %d: %w", ar.line+1, err)
+	}
+	return io.EOF
+}
@@ -0,0 +1,95 @@
+package archive_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonomouskoi/trackstar-live/server/archive"
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	updates := []*trackstar.TrackUpdate{
+		{
+			DeckId: "1",
+			Track:  &trackstar.Track{Artist: "An Artist", Title: "A Title"},
+			When:   1709326805000,
+			Index:  1,
+		},
+		{
+			DeckId: "2",
+			Track:  &trackstar.Track{Title: "ID"},
+			When:   1709326990500,
+			Index:  2,
+		},
+	}
+	buf := &bytes.Buffer{}
+	aw, err := archive.NewWriter(buf, "test-user", 1709400000000)
+	require.NoError(t, err)
+	for _, tu := range updates {
+		require.NoError(t, aw.Write(1709326800000, false, tu))
+	}
+	require.NoError(t, aw.Write(1709500000000, true, updates[0]), "unlisted set")
+
+	ar, err := archive.NewReader(buf)
+	require.NoError(t, err)
+	require.Equal(t, archive.Header{
+		Format:   archive.Format,
+		Version:  archive.Version,
+		UserID:   "test-user",
+		Exported: 1709400000000,
+	}, ar.Header)
+	for _, want := range updates {
+		record, err := ar.Next()
+		require.NoError(t, err)
+		require.Equal(t, int64(1709326800000), record.Started)
+		require.False(t, record.Unlisted)
+		got := record.TrackUpdate()
+		require.Equal(t, want.GetDeckId(), got.GetDeckId())
+		require.Equal(t, want.GetTrack().GetArtist(), got.GetTrack().GetArtist())
+		require.Equal(t, want.GetTrack().GetTitle(), got.GetTrack().GetTitle())
+		require.Equal(t, want.GetWhen(), got.GetWhen())
+		require.Equal(t, want.GetIndex(), got.GetIndex())
+	}
+	record, err := ar.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1709500000000), record.Started)
+	require.True(t, record.Unlisted, "unlisted set")
+	_, err = ar.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestReaderErrors(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		input string
+		err   error
+	}{
+		"empty":      {input: "", err: archive.ErrNotArchive},
+		"not header": {input: `{"format":"something-else","version":1}`, err: archive.ErrNotArchive},
+		"too new":    {input: `{"format":"trackstar-live-archive","version":2}`, err: archive.ErrUnsupportedVersion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := archive.NewReader(strings.NewReader(tc.input))
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	ar, err := archive.NewReader(strings.NewReader(`{"format":"trackstar-live-archive","version":1}
+
+{"started":1,"when":2}
+{"started":1}
+`))
+	require.NoError(t, err)
+	_, err = ar.Next()
+	require.NoError(t, err, "blank lines are skipped")
+	_, err = ar.Next()
+	require.ErrorContains(t, err, "line 4")
+}
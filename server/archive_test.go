@@ -0,0 +1,51 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+// an archive exported from one server and imported into another keeps which
+// sets are unlisted
+func TestArchiveUnlisted(t *testing.T) {
+	t.Parallel()
+
+	const listed, unlisted = 1709326800000, 1709500000000
+	from, fromStore := newTestServer(t)
+	for _, started := range []int64{listed, unlisted} {
+		require.NoError(t, fromStore.AddTrackUpdate(t.Context(), "test-user", started, &trackstar.TrackUpdate{
+			Track: &trackstar.Track{Artist: "An Artist", Title: "A Title"},
+			When:  started + 1000,
+			Index: 1,
+		}))
+	}
+	require.NoError(t, fromStore.SessionSetUnlisted(t.Context(), "test-user", unlisted, true))
+
+	token, err := from.auth.issue("test-user")
+	require.NoError(t, err)
+	r := httptest.NewRequest("GET", "/_archive/test-user", nil)
+	r.Header.Set(headerToken, token.RawToken)
+	w := httptest.NewRecorder()
+	from.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	to, toStore := newTestServer(t)
+	r = httptest.NewRequest("POST", "/_archive/test-user", bytes.NewReader(w.Body.Bytes()))
+	r.Header.Set(headerToken, token.RawToken)
+	w = httptest.NewRecorder()
+	to.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	sessions, err := toStore.SessionsList(t.Context(), "test-user")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int64{listed, unlisted}, sessions)
+	gotUnlisted, err := toStore.SessionsUnlisted(t.Context(), "test-user")
+	require.NoError(t, err)
+	require.Equal(t, []int64{unlisted}, gotUnlisted)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/autonomouskoi/trackstar-live/server"
+	"github.com/autonomouskoi/trackstar-live/server/history"
+	"github.com/autonomouskoi/trackstar-live/server/store"
+	"github.com/autonomouskoi/trackstar-live/server/store/sqlite3"
+)
+
+func fatal(v ...any) {
+	fmt.Fprintln(os.Stderr, v...)
+	os.Exit(-1)
+}
+
+func fatalIfError(err error, msg string) {
+	if err != nil {
+		fatal("error: ", msg, ": ", err)
+	}
+}
+
+// history_import imports Serato, rekordbox or Traktor history files into a
+// user's sets. Times in the files are taken to be UTC, as with the import
+// endpoint; set TZ to use another zone.
+func main() {
+	if len(os.Args) < 4 {
+		fatal("usage: ", os.Args[0], "<config path>", "<user id>", "<history file>...")
+	}
+
+	cfg, err := server.LoadConfig(os.Args[1])
+	fatalIfError(err, "loading config")
+
+	db, err := sqlite3.New(cfg.DBPath)
+	fatalIfError(err, "opening database")
+	defer db.Close()
+	st := store.New(db)
+
+	loc := time.UTC
+	if os.Getenv("TZ") != "" {
+		// time.Local is loaded from TZ
+		loc = time.Local
+	}
+
+	userID := os.Args[2]
+	for _, path := range os.Args[3:] {
+		b, err := os.ReadFile(path)
+		fatalIfError(err, "reading "+path)
+		imp, err := history.Parse(b, loc)
+		fatalIfError(err, "parsing "+path)
+		report, err := server.ImportHistory(context.Background(), st, userID, imp)
+		fatalIfError(err, "importing "+path)
+
+		fmt.Printf("%s (%s): %d imported, %d duplicates\n", path, report.Format, report.Imported, report.Duplicates)
+		for _, s := range report.Sessions {
+			estimated := ""
+			if s.Estimated {
+				estimated = ", times estimated"
+			}
+			fmt.Printf("  %s %q: %d tracks, %d imported, %d duplicates%s\n",
+				time.UnixMilli(s.Started).In(loc).Format(time.DateTime),
+				s.Name, s.Tracks, s.Imported, s.Duplicates, estimated,
+			)
+		}
+	}
+}
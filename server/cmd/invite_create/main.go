@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/autonomouskoi/trackstar-live/server"
+	"github.com/autonomouskoi/trackstar-live/server/store"
+	"github.com/autonomouskoi/trackstar-live/server/store/sqlite3"
+)
+
+var userIDRE = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+func fatal(v ...any) {
+	fmt.Fprintln(os.Stderr, v...)
+	os.Exit(-1)
+}
+
+func fatalIfError(err error, msg string) {
+	if err != nil {
+		fatal("error: ", msg, ": ", err)
+	}
+}
+
+func main() {
+	if len(os.Args) != 3 && len(os.Args) != 4 {
+		fatal("usage: ", os.Args[0], "<config path>", "<user id>", "[expires in]")
+	}
+
+	cfg, err := server.LoadConfig(os.Args[1])
+	fatalIfError(err, "loading config")
+
+	userID := os.Args[2]
+	if !userIDRE.MatchString(userID) {
+		fatal("invalid user id: ", userID)
+	}
+
+	var expires int64
+	if len(os.Args) == 4 {
+		expiresIn, err := time.ParseDuration(os.Args[3])
+		fatalIfError(err, "parsing expiry")
+		expires = time.Now().Add(expiresIn).UnixMilli()
+	}
+
+	db, err := sqlite3.New(cfg.DBPath)
+	fatalIfError(err, "opening database")
+	defer db.Close()
+
+	code, err := server.NewInviteCode()
+	fatalIfError(err, "generating invite code")
+
+	err = store.New(db).InviteCreate(context.Background(), code, userID, expires)
+	fatalIfError(err, "creating invite")
+
+	u, err := url.Parse(cfg.MyURL)
+	fatalIfError(err, "parsing server URL")
+	u.Path = path.Join(u.Path, "_redeem")
+
+	fmt.Printf(`
+Code:    %s
+User:    %s
+Redeem:  curl -d code=%s %s
+`,
+		code, userID, code, u,
+	)
+	if expires != 0 {
+		fmt.Println("Expires:", time.UnixMilli(expires).Format(time.RFC1123))
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/autonomouskoi/trackstar-live/server"
+)
+
+func fatal(v ...any) {
+	fmt.Fprintln(os.Stderr, v...)
+	os.Exit(-1)
+}
+
+func fatalIfError(err error, msg string) {
+	if err != nil {
+		fatal("error: ", msg, ": ", err)
+	}
+}
+
+func loadToken(path string) (*server.Token, error) {
+	protoB64, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	protoB := make([]byte, base64.RawStdEncoding.DecodedLen(len(protoB64)))
+	n, err := base64.StdEncoding.Decode(protoB, protoB64)
+	if err != nil {
+		return nil, fmt.Errorf("decoding base64: %w", err)
+	}
+	protoB = protoB[:n]
+	var t server.Token
+	if err := proto.Unmarshal(protoB, &t); err != nil {
+		return nil, fmt.Errorf("unmarshalling token: %w", err)
+	}
+	return &t, nil
+}
+
+func formatMillis(millis int64) string {
+	if millis == 0 {
+		return "-"
+	}
+	return time.UnixMilli(millis).Format(time.RFC1123)
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		fatal("usage: ", os.Args[0], "<token path>")
+	}
+
+	token, err := loadToken(os.Args[1])
+	fatalIfError(err, "loading token")
+
+	fmt.Printf(`
+Token
+User:     %s
+Issuer:   %s
+Audience: %v
+Issued:   %s
+Expires:  %s
+`,
+		token.GetSubject(),
+		token.GetIssuer(),
+		token.GetAudience(),
+		formatMillis(token.GetIssuedAt()),
+		formatMillis(token.GetExpiresAt()),
+	)
+
+	u, err := url.Parse(token.GetIssuer())
+	fatalIfError(err, "parsing issuer URL")
+	u.Path = path.Join(u.Path, "_whoami")
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	fatalIfError(err, "creating request")
+	req.Header.Set("x-extension-jwt", token.GetRawToken())
+	req.Header.Set("Accept", "application/protobuf")
+
+	resp, err := http.DefaultClient.Do(req)
+	fatalIfError(err, "sending request")
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		fmt.Fprintln(os.Stderr, resp.Status)
+		io.Copy(os.Stderr, resp.Body)
+		fatal("request failed")
+	}
+	b, err := io.ReadAll(resp.Body)
+	fatalIfError(err, "reading response")
+	var who server.WhoamiResponse
+	fatalIfError(proto.Unmarshal(b, &who), "unmarshalling response")
+
+	fmt.Printf(`
+Server
+User:     %s
+Scopes:   %v
+Issued:   %s
+Expires:  %s
+Revoked:  %t
+`,
+		who.GetSubject(),
+		who.GetScopes(),
+		formatMillis(who.GetIssuedAt()),
+		formatMillis(who.GetExpiresAt()),
+		who.GetRevoked(),
+	)
+}
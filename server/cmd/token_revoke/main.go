@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/autonomouskoi/trackstar-live/server"
+	"github.com/autonomouskoi/trackstar-live/server/store"
+	"github.com/autonomouskoi/trackstar-live/server/store/sqlite3"
+)
+
+func fatal(v ...any) {
+	fmt.Fprintln(os.Stderr, v...)
+	os.Exit(-1)
+}
+
+func fatalIfError(err error, msg string) {
+	if err != nil {
+		fatal("error: ", msg, ": ", err)
+	}
+}
+
+// token_revoke revokes every token issued to a user so far. Tokens only
+// record the second they were issued, so ones issued later in the same second
+// are revoked too.
+func main() {
+	if len(os.Args) != 3 {
+		fatal("usage: ", os.Args[0], "<config path>", "<user id>")
+	}
+
+	cfg, err := server.LoadConfig(os.Args[1])
+	fatalIfError(err, "loading config")
+
+	db, err := sqlite3.New(cfg.DBPath)
+	fatalIfError(err, "opening database")
+	defer db.Close()
+
+	now := time.Now()
+	err = store.New(db).TokensRevoke(context.Background(), os.Args[2], now.UnixMilli())
+	fatalIfError(err, "revoking tokens")
+
+	fmt.Println("Revoked tokens for", os.Args[2], "issued before", now.Format(time.RFC1123))
+}
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -14,9 +16,103 @@ type ServerConfig struct {
 	LogDebug   bool   `yaml:"log_debug"`
 	DBPath     string `yaml:"db_path"`
 	HTMLPath   string `yaml:"html_path"`
+	// TokenLifetime is how long issued tokens are valid. The plugin refreshes
+	// tokens before they expire.
+	TokenLifetime time.Duration `yaml:"token_lifetime"`
+	// PrivateSets requires a signed share link to view a set
+	PrivateSets bool `yaml:"private_sets"`
+	// RealIPHeader names a header carrying the client's address, e.g.
+	// X-Forwarded-For when running behind a reverse proxy
+	RealIPHeader string `yaml:"real_ip_header"`
+	// TrustedProxies is how many reverse proxies append to RealIPHeader. The
+	// client's address is that many entries from the right; entries further
+	// left are set by the client. Defaults to 1.
+	TrustedProxies int              `yaml:"trusted_proxies"`
+	RateLimits     RateLimitsConfig `yaml:"rate_limits"`
+	IssueLockout   LockoutConfig    `yaml:"issue_lockout"`
+}
+
+// RateLimit configures a token bucket. Rate is the sustained number of
+// requests per second and Burst is the size of the bucket. Fields left unset
+// get defaults; a negative Rate disables the limit.
+type RateLimit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+type RateLimitsConfig struct {
+	IssuePerIP            RateLimit `yaml:"issue_per_ip"`
+	TrackUpdatePerIP      RateLimit `yaml:"track_update_per_ip"`
+	TrackUpdatePerSubject RateLimit `yaml:"track_update_per_subject"`
+}
+
+// LockoutConfig locks a client out for Duration after MaxFailures failed
+// attempts within Window. Fields left unset get defaults.
+type LockoutConfig struct {
+	MaxFailures int           `yaml:"max_failures"`
+	Window      time.Duration `yaml:"window"`
+	Duration    time.Duration `yaml:"duration"`
+}
+
+// setDefaults fills in any limits left unset
+func (c *ServerConfig) setDefaults() {
+	for _, rl := range []struct {
+		limit *RateLimit
+		def   RateLimit
+	}{
+		{&c.RateLimits.IssuePerIP, RateLimit{Rate: 0.1, Burst: 5}},
+		{&c.RateLimits.TrackUpdatePerIP, RateLimit{Rate: 2, Burst: 30}},
+		{&c.RateLimits.TrackUpdatePerSubject, RateLimit{Rate: 0.5, Burst: 10}},
+	} {
+		if rl.limit.Rate == 0 {
+			rl.limit.Rate = rl.def.Rate
+		}
+		if rl.limit.Burst == 0 {
+			rl.limit.Burst = rl.def.Burst
+		}
+	}
+	if c.TokenLifetime == 0 {
+		c.TokenLifetime = defaultTokenLifetime
+	}
+	if c.TrustedProxies == 0 {
+		c.TrustedProxies = 1
+	}
+	if c.IssueLockout.MaxFailures == 0 {
+		c.IssueLockout.MaxFailures = 5
+	}
+	if c.IssueLockout.Window == 0 {
+		c.IssueLockout.Window = time.Minute * 15
+	}
+	if c.IssueLockout.Duration == 0 {
+		c.IssueLockout.Duration = time.Hour
+	}
 }
 
 func (c *ServerConfig) Validate() error {
+	if c.TokenLifetime < time.Minute {
+		return errors.New("token_lifetime must be at least 1m")
+	}
+	if c.TrustedProxies < 1 {
+		return errors.New("trusted_proxies must be at least 1")
+	}
+	for name, rl := range map[string]RateLimit{
+		"issue_per_ip":             c.RateLimits.IssuePerIP,
+		"track_update_per_ip":      c.RateLimits.TrackUpdatePerIP,
+		"track_update_per_subject": c.RateLimits.TrackUpdatePerSubject,
+	} {
+		if rl.Burst < 1 {
+			return fmt.Errorf("rate_limits.%s.burst must be at least 1", name)
+		}
+	}
+	if c.IssueLockout.MaxFailures < 1 {
+		return errors.New("issue_lockout.max_failures must be at least 1")
+	}
+	if c.IssueLockout.Window < time.Second {
+		return errors.New("issue_lockout.window must be at least 1s")
+	}
+	if c.IssueLockout.Duration < time.Second {
+		return errors.New("issue_lockout.duration must be at least 1s")
+	}
 	return nil
 }
 
@@ -29,6 +125,7 @@ func LoadConfig(path string) (*ServerConfig, error) {
 	if err := yaml.Unmarshal(b, &cfg); err != nil {
 		return nil, fmt.Errorf("parsing config: %w", err)
 	}
+	cfg.setDefaults()
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("validating config: %w", err)
 	}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigRateLimitDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := &ServerConfig{
+		RateLimits: RateLimitsConfig{
+			IssuePerIP:       RateLimit{Burst: 20},
+			TrackUpdatePerIP: RateLimit{Rate: -1},
+		},
+	}
+	cfg.setDefaults()
+	require.Equal(t, RateLimit{Rate: 0.1, Burst: 20}, cfg.RateLimits.IssuePerIP, "setting burst keeps the default rate")
+	require.Equal(t, RateLimit{Rate: -1, Burst: 30}, cfg.RateLimits.TrackUpdatePerIP, "disabled")
+	require.Equal(t, RateLimit{Rate: 0.5, Burst: 10}, cfg.RateLimits.TrackUpdatePerSubject)
+	require.NoError(t, cfg.Validate())
+	require.NotNil(t, newRateLimiter(cfg.RateLimits.IssuePerIP))
+	require.Nil(t, newRateLimiter(cfg.RateLimits.TrackUpdatePerIP))
+
+	cfg.RateLimits.IssuePerIP.Burst = -1
+	require.ErrorContains(t, cfg.Validate(), "rate_limits.issue_per_ip.burst")
+}
+
+func TestConfigLockoutDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := &ServerConfig{
+		IssueLockout: LockoutConfig{MaxFailures: 10},
+	}
+	cfg.setDefaults()
+	require.Equal(t, LockoutConfig{
+		MaxFailures: 10,
+		Window:      time.Minute * 15,
+		Duration:    time.Hour,
+	}, cfg.IssueLockout, "unset fields get defaults")
+	require.NoError(t, cfg.Validate())
+
+	cfg.IssueLockout.Duration = -time.Hour
+	require.ErrorContains(t, cfg.Validate(), "issue_lockout.duration")
+	cfg.IssueLockout.Duration = time.Hour
+	cfg.IssueLockout.Window = time.Millisecond
+	require.ErrorContains(t, cfg.Validate(), "issue_lockout.window")
+}
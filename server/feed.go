@@ -0,0 +1,98 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/autonomouskoi/trackstar-live/server/formats"
+)
+
+// feedMaxSets is how many of a DJ's most recent sets are in their feeds
+const feedMaxSets = 10
+
+// handleFeed serves a feed of the path's user's recent sets written by write.
+// Feeds are only available when sets aren't private and leave out unlisted
+// sets.
+func (srv *Server) handleFeed(contentType string, write func(io.Writer, *formats.Feed) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID := r.PathValue("userID")
+		if srv.privateSets {
+			http.Error(w, "sets are private", http.StatusForbidden)
+			return
+		}
+		feed, err := srv.feed(r, userID)
+		if err != nil {
+			defaultHTTPError(w, http.StatusInternalServerError)
+			srv.logger.Error("building feed",
+				"remote", r.RemoteAddr,
+				"user_id", userID,
+				"error", err.Error(),
+			)
+			return
+		}
+		buf := &bytes.Buffer{}
+		if err := write(buf, feed); err != nil {
+			defaultHTTPError(w, http.StatusInternalServerError)
+			srv.logger.Error("writing feed",
+				"remote", r.RemoteAddr,
+				"user_id", userID,
+				"error", err.Error(),
+			)
+			return
+		}
+		sum := sha256.Sum256(buf.Bytes())
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+		w.Header().Set(headerContentType, contentType)
+		var modified time.Time
+		if updated := feed.Updated(); updated != 0 {
+			modified = time.UnixMilli(updated)
+		}
+		// handles If-None-Match and If-Modified-Since
+		http.ServeContent(w, r, "", modified, bytes.NewReader(buf.Bytes()))
+	}
+}
+
+// feed gets userID's most recent sets
+func (srv *Server) feed(r *http.Request, userID string) (*formats.Feed, error) {
+	u, err := url.Parse(srv.myURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path.Join(u.Path, "u", userID)
+	feed := &formats.Feed{
+		UserID: userID,
+		URL:    u.String(),
+		Since:  srv.started.UnixMilli(),
+	}
+	u.Path = r.URL.Path
+	feed.FeedURL = u.String()
+
+	sessions, err := srv.store.SessionsList(r.Context(), userID)
+	if err != nil {
+		return nil, err
+	}
+	if sessions, err = srv.listed(r.Context(), userID, sessions); err != nil {
+		return nil, err
+	}
+	if len(sessions) > feedMaxSets {
+		sessions = sessions[:feedMaxSets]
+	}
+	for _, started := range sessions {
+		updates, err := srv.store.SessionGet(r.Context(), userID, started)
+		if err != nil {
+			return nil, err
+		}
+		feed.Sets = append(feed.Sets, &formats.Set{
+			UserID:  userID,
+			Started: started,
+			Updates: updates,
+		})
+	}
+	return feed, nil
+}
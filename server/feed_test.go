@@ -0,0 +1,34 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleFeedEmpty(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newTestServer(t)
+	get := func(etag string) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest("GET", "/u/test-user/feed.xml", nil)
+		if etag != "" {
+			r.Header.Set("If-None-Match", etag)
+		}
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		return w
+	}
+
+	w := get("")
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, srv.started.UTC().Format(http.TimeFormat), w.Header().Get("Last-Modified"))
+	require.Contains(t, w.Body.String(), "<updated>"+srv.started.UTC().Format("2006-01-02T15:04:05Z")+"</updated>")
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	require.Equal(t, http.StatusNotModified, get(etag).Code, "unchanged while the server runs")
+}
@@ -0,0 +1,65 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// cueMaxTracks is the most tracks a cue sheet can have
+const cueMaxTracks = 99
+
+// ErrTooManyTracks is returned when a set has more tracks than a format allows
+var ErrTooManyTracks = fmt.Errorf("too many tracks")
+
+// writeCue writes a cue sheet for a recording of the set, with a track starting
+// when each update was played. The recording is assumed to start when the set
+// did, adjusted by the set's offset.
+func writeCue(w io.Writer, set *Set) error {
+	if len(set.Updates) > cueMaxTracks {
+		return fmt.Errorf("%w: cue sheets are limited to %d", ErrTooManyTracks, cueMaxTracks)
+	}
+	file := set.File
+	if file == "" {
+		file = set.UserID + "-" + time.UnixMilli(set.Started).Format(time.DateOnly) + ".wav"
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "REM DATE %s\n", time.UnixMilli(set.Started).UTC().Format(time.DateOnly))
+	fmt.Fprintf(bw, "PERFORMER %s\n", cueString(set.UserID))
+	fmt.Fprintf(bw, "TITLE %s\n", cueString(playlistName(set)))
+	fmt.Fprintf(bw, "FILE %s %s\n", cueString(file), cueFileType(file))
+	for i, tu := range set.Updates {
+		fmt.Fprintf(bw, "  TRACK %02d AUDIO\n", i+1)
+		fmt.Fprintf(bw, "    TITLE %s\n", cueString(tu.GetTrack().GetTitle()))
+		fmt.Fprintf(bw, "    PERFORMER %s\n", cueString(tu.GetTrack().GetArtist()))
+		fmt.Fprintf(bw, "    INDEX 01 %s\n", cueTime(tu.GetWhen()-set.Started+set.Offset))
+	}
+	return bw.Flush()
+}
+
+// cueString quotes s. Cue sheets can't escape quotes or line breaks, so
+// they're replaced.
+func cueString(s string) string {
+	return `"` + strings.ReplaceAll(oneLine(s), `"`, "'") + `"`
+}
+
+// cueFileType is the cue sheet type of the audio in file, by its extension
+func cueFileType(file string) string {
+	switch strings.ToLower(path.Ext(file)) {
+	case ".mp3":
+		return "MP3"
+	case ".aif", ".aiff":
+		return "AIFF"
+	}
+	return "WAVE"
+}
+
+// cueTime formats millis as mm:ss:ff, where there are 75 frames per second.
+// Negative times are clamped to 0.
+func cueTime(millis int64) string {
+	frames := max(millis, 0) * 75 / 1000
+	return fmt.Sprintf("%02d:%02d:%02d", frames/75/60, frames/75%60, frames%75)
+}
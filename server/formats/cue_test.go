@@ -0,0 +1,59 @@
+package formats
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+func TestCue(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeCue(buf, testSet()))
+	require.Equal(t, `REM DATE 2024-03-01
+PERFORMER "test-user"
+TITLE "test-user 2024-03-01 21:00"
+FILE "test-user-2024-03-01.wav" WAVE
+  TRACK 01 AUDIO
+    TITLE "First Title"
+    PERFORMER "First Artist"
+    INDEX 01 00:05:00
+  TRACK 02 AUDIO
+    TITLE "Second Title"
+    PERFORMER "Second Artist"
+    INDEX 01 03:10:37
+  TRACK 03 AUDIO
+    TITLE "ID"
+    PERFORMER ""
+    INDEX 01 07:10:37
+`, buf.String())
+
+	set := testSet()
+	set.File = "my set.mp3"
+	set.Offset = -10000
+	set.Updates[0].Track.Title = `"Quoted"`
+	buf.Reset()
+	require.NoError(t, writeCue(buf, set))
+	require.Contains(t, buf.String(), `FILE "my set.mp3" MP3`)
+	require.Contains(t, buf.String(), `TITLE "'Quoted'"`)
+	require.Contains(t, buf.String(), "INDEX 01 00:00:00\n", "clamped")
+	require.Contains(t, buf.String(), "INDEX 01 03:00:37\n", "offset")
+
+	set = testSet()
+	set.Updates[0].Track.Artist = "Evil\r\nFILE \"/etc/passwd\" WAVE\nINDEX 01 00:00:00"
+	buf.Reset()
+	require.NoError(t, writeCue(buf, set))
+	require.Contains(t, buf.String(), `    PERFORMER "Evil FILE '/etc/passwd' WAVE INDEX 01 00:00:00"`+"\n", "line breaks")
+	require.Equal(t, 1, strings.Count(buf.String(), "\nFILE "), "no injected commands")
+
+	set = testSet()
+	for len(set.Updates) <= cueMaxTracks {
+		set.Updates = append(set.Updates, &trackstar.TrackUpdate{})
+	}
+	require.ErrorIs(t, writeCue(buf, set), ErrTooManyTracks)
+}
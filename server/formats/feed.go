@@ -0,0 +1,202 @@
+package formats
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Feed is a DJ's recent sets, newest first
+type Feed struct {
+	UserID string
+	// URL is the DJ's page. Each set's page is under it.
+	URL string
+	// FeedURL is where the feed itself is served
+	FeedURL string
+	// Since is when the feed is considered to have last changed if it has
+	// no sets, in milliseconds
+	Since int64
+	Sets  []*Set
+}
+
+// Updated is when the feed last changed, in milliseconds. It's Since if there
+// are no sets.
+func (f *Feed) Updated() int64 {
+	if len(f.Sets) == 0 {
+		return f.Since
+	}
+	var updated int64
+	for _, set := range f.Sets {
+		updated = max(updated, setUpdated(set))
+	}
+	return updated
+}
+
+// setUpdated is when set last changed: when its last track was played, or
+// when it started
+func setUpdated(set *Set) int64 {
+	updated := set.Started
+	for _, tu := range set.Updates {
+		updated = max(updated, tu.GetWhen())
+	}
+	return updated
+}
+
+func (f *Feed) title() string {
+	return f.UserID + "'s sets"
+}
+
+func (f *Feed) setURL(set *Set) string {
+	return strings.TrimSuffix(f.URL, "/") + "/" + strconv.FormatInt(set.Started, 10)
+}
+
+func setTitle(set *Set) string {
+	return "Set started " + time.UnixMilli(set.Started).UTC().Format("2006-01-02 15:04 MST")
+}
+
+// setSummary names the last track played, which for a set in progress is the
+// current track
+func setSummary(set *Set) string {
+	if len(set.Updates) == 0 {
+		return "No tracks yet"
+	}
+	return "Latest track: " + displayName(set.Updates[len(set.Updates)-1])
+}
+
+// setContent is set's tracklist as plain text
+func setContent(set *Set) (string, error) {
+	b := &strings.Builder{}
+	if err := writeTracklist(b, &Set{
+		UserID:  set.UserID,
+		Started: set.Started,
+		Updates: set.Updates,
+	}); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func feedTime(millis int64) string {
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Link      atomLink   `xml:"link"`
+	Summary   string     `xml:"summary"`
+	Content   atomString `xml:"content"`
+}
+
+type atomString struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+// WriteAtom writes feed as an Atom feed
+func WriteAtom(w io.Writer, feed *Feed) error {
+	doc := atomFeed{
+		ID:      feed.URL,
+		Title:   feed.title(),
+		Updated: feedTime(feed.Updated()),
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: feed.FeedURL},
+			{Rel: "alternate", Type: "text/html", Href: feed.URL},
+		},
+		Author: atomAuthor{Name: feed.UserID},
+	}
+	for _, set := range feed.Sets {
+		content, err := setContent(set)
+		if err != nil {
+			return err
+		}
+		doc.Entries = append(doc.Entries, atomEntry{
+			ID:        feed.setURL(set),
+			Title:     setTitle(set),
+			Published: feedTime(set.Started),
+			Updated:   feedTime(setUpdated(set)),
+			Link:      atomLink{Rel: "alternate", Type: "text/html", Href: feed.setURL(set)},
+			Summary:   setSummary(set),
+			Content:   atomString{Type: "text", Text: content},
+		})
+	}
+	return writeXML(w, doc)
+}
+
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url"`
+	FeedURL     string           `json:"feed_url"`
+	Authors     []jsonFeedAuthor `json:"authors"`
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string `json:"id"`
+	URL           string `json:"url"`
+	Title         string `json:"title"`
+	Summary       string `json:"summary"`
+	ContentText   string `json:"content_text"`
+	DatePublished string `json:"date_published"`
+	DateModified  string `json:"date_modified"`
+}
+
+// WriteJSONFeed writes feed as a JSON Feed 1.1
+func WriteJSONFeed(w io.Writer, feed *Feed) error {
+	doc := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.title(),
+		HomePageURL: feed.URL,
+		FeedURL:     feed.FeedURL,
+		Authors:     []jsonFeedAuthor{{Name: feed.UserID}},
+		Items:       []jsonFeedItem{},
+	}
+	for _, set := range feed.Sets {
+		content, err := setContent(set)
+		if err != nil {
+			return err
+		}
+		doc.Items = append(doc.Items, jsonFeedItem{
+			ID:            feed.setURL(set),
+			URL:           feed.setURL(set),
+			Title:         setTitle(set),
+			Summary:       setSummary(set),
+			ContentText:   content,
+			DatePublished: feedTime(set.Started),
+			DateModified:  feedTime(setUpdated(set)),
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
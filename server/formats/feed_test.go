@@ -0,0 +1,55 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testFeed() *Feed {
+	older := testSet()
+	older.Started -= 86400000
+	older.Updates = older.Updates[:1]
+	older.Updates[0].When -= 86400000
+	return &Feed{
+		UserID:  "test-user",
+		URL:     "https://live.example.com/u/test-user",
+		FeedURL: "https://live.example.com/u/test-user/feed.xml",
+		Sets:    []*Set{testSet(), older},
+	}
+}
+
+func TestFeedUpdated(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, int64(1709326800000+5000+185500+240000), testFeed().Updated())
+	require.Equal(t, int64(1709326800000), (&Feed{Since: 1709326800000}).Updated())
+}
+
+func TestFeedEmpty(t *testing.T) {
+	t.Parallel()
+	feed := &Feed{
+		UserID:  "test-user",
+		URL:     "https://live.example.com/u/test-user",
+		FeedURL: "https://live.example.com/u/test-user/feed.xml",
+		Since:   1709326800000,
+	}
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteAtom(buf, feed))
+	require.Contains(t, buf.String(), "<updated>2024-03-01T21:00:00Z</updated>")
+	require.NotContains(t, buf.String(), "1970")
+}
+
+func TestAtom(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteAtom(buf, testFeed()))
+	requireGolden(t, "feed.atom", buf.Bytes())
+}
+
+func TestJSONFeed(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteJSONFeed(buf, testFeed()))
+	requireGolden(t, "feed.json", buf.Bytes())
+}
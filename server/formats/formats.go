@@ -0,0 +1,207 @@
+// Package formats renders sets in formats DJ software and other tools can
+// import.
+package formats
+
+import (
+	"cmp"
+	"io"
+	"mime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+// Set is a session's track updates, in the order they were played. Times are
+// in milliseconds.
+type Set struct {
+	UserID  string
+	Started int64
+	Updates []*trackstar.TrackUpdate
+	// Offset is added to track offsets in timestamped formats, e.g. for a
+	// recording that started before the set
+	Offset int64
+	// Columns selects and orders the columns of tabular formats. If empty,
+	// DefaultColumns are used.
+	Columns []string
+	// Location is the time zone times are written in. If nil, UTC is used.
+	Location *time.Location
+	// Delimiter separates CSV fields. If 0, a comma is used.
+	Delimiter rune
+	// File is the recording a cue sheet refers to
+	File string
+	// Template renders each line of a tracklist. If nil, DefaultTemplate is
+	// used.
+	Template *Template
+}
+
+// Format is a way of rendering a set
+type Format struct {
+	// Name selects the format with the download query parameter
+	Name        string
+	ContentType string
+	// Accept lists media types that select the format in an Accept header
+	Accept    []string
+	Extension string
+	Write     func(w io.Writer, set *Set) error
+}
+
+var formats = []*Format{
+	{
+		Name:        "csv",
+		ContentType: "text/csv; charset=utf-8",
+		Accept:      []string{"text/csv"},
+		Extension:   "csv",
+		Write:       writeCSV,
+	},
+	{
+		Name:        "tsv",
+		ContentType: "text/tab-separated-values; charset=utf-8",
+		Accept:      []string{"text/tab-separated-values"},
+		Extension:   "tsv",
+		Write:       writeTSV,
+	},
+	{
+		Name:        "m3u",
+		ContentType: "audio/x-mpegurl; charset=utf-8",
+		Accept:      []string{"audio/x-mpegurl", "audio/mpegurl"},
+		Extension:   "m3u",
+		Write:       writeM3U,
+	},
+	{
+		Name:        "m3u8",
+		ContentType: "application/vnd.apple.mpegurl",
+		Accept:      []string{"application/vnd.apple.mpegurl", "application/x-mpegurl"},
+		Extension:   "m3u8",
+		Write:       writeM3U,
+	},
+	{
+		Name:        "pls",
+		ContentType: "audio/x-scpls",
+		Accept:      []string{"audio/x-scpls"},
+		Extension:   "pls",
+		Write:       writePLS,
+	},
+	{
+		Name:        "tracklist",
+		ContentType: "text/plain; charset=utf-8",
+		Extension:   "txt",
+		Write:       writeTracklist,
+	},
+	{
+		Name:        "rekordbox",
+		ContentType: "application/xml; charset=utf-8",
+		Extension:   "xml",
+		Write:       writeRekordbox,
+	},
+	{
+		Name:        "nml",
+		ContentType: "application/xml; charset=utf-8",
+		Extension:   "nml",
+		Write:       writeNML,
+	},
+	{
+		Name:        "cue",
+		ContentType: "application/x-cue; charset=utf-8",
+		Extension:   "cue",
+		Write:       writeCue,
+	},
+}
+
+// Lookup returns the format with name, or nil if there isn't one
+func Lookup(name string) *Format {
+	for _, f := range formats {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// jsonMediaTypes are satisfied by the JSON sets are served as by default
+var jsonMediaTypes = []string{"application/json", "application/*", "*/*"}
+
+// ForAccept returns the format for the most preferred media type in accept, an
+// Accept header value. Media types with a quality of 0 are never picked. If
+// none match, or the default JSON is preferred at least as much, nil is
+// returned.
+func ForAccept(accept string) *Format {
+	type acceptable struct {
+		mediaType string
+		q         float64
+	}
+	var acceptables []acceptable
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if qStr, present := params["q"]; present {
+			if q, err = strconv.ParseFloat(qStr, 64); err != nil {
+				continue
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		acceptables = append(acceptables, acceptable{mediaType: mediaType, q: q})
+	}
+	// equally preferred media types keep the order they were given in
+	slices.SortStableFunc(acceptables, func(a, b acceptable) int {
+		return cmp.Compare(b.q, a.q)
+	})
+	for _, a := range acceptables {
+		if slices.Contains(jsonMediaTypes, a.mediaType) {
+			return nil
+		}
+		for _, f := range formats {
+			if slices.Contains(f.Accept, a.mediaType) {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// Filename is what a download of set in f is called
+func (f *Format) Filename(set *Set) string {
+	return set.UserID + "-" + time.UnixMilli(set.Started).Format(time.DateOnly) + "." + f.Extension
+}
+
+// lineBreaks are replaced by oneLine
+var lineBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// oneLine replaces line breaks in s with spaces, so a value from a track can't
+// start a new line, and a new entry or command, in a line based format
+func oneLine(s string) string {
+	return lineBreaks.Replace(s)
+}
+
+// displayName is how a track is shown in formats with a single line for it
+func displayName(tu *trackstar.TrackUpdate) string {
+	artist, title := tu.GetTrack().GetArtist(), tu.GetTrack().GetTitle()
+	switch {
+	case artist == "":
+		return title
+	case title == "":
+		return artist
+	}
+	return artist + " - " + title
+}
+
+// durations estimates how long each track played, in milliseconds, from when
+// the next one started. The last track's duration isn't known and is -1.
+func durations(updates []*trackstar.TrackUpdate) []int64 {
+	d := make([]int64, len(updates))
+	for i := range updates {
+		if i == len(updates)-1 {
+			d[i] = -1
+			continue
+		}
+		d[i] = max(updates[i+1].GetWhen()-updates[i].GetWhen(), 0)
+	}
+	return d
+}
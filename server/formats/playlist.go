@@ -0,0 +1,46 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"time"
+)
+
+// writeM3U writes an extended M3U playlist. There are no files to point at, so
+// each entry's location is the track's name.
+func writeM3U(w io.Writer, set *Set) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "#EXTM3U")
+	fmt.Fprintf(bw, "#PLAYLIST:%s %s\n", set.UserID, time.UnixMilli(set.Started).UTC().Format(time.RFC3339))
+	for i, d := range durations(set.Updates) {
+		name := oneLine(displayName(set.Updates[i]))
+		fmt.Fprintf(bw, "#EXTINF:%d,%s\n", seconds(d), name)
+		fmt.Fprintln(bw, name)
+	}
+	return bw.Flush()
+}
+
+// writePLS writes a PLS version 2 playlist
+func writePLS(w io.Writer, set *Set) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "[playlist]")
+	for i, d := range durations(set.Updates) {
+		name := oneLine(displayName(set.Updates[i]))
+		fmt.Fprintf(bw, "File%d=%s\n", i+1, name)
+		fmt.Fprintf(bw, "Title%d=%s\n", i+1, name)
+		fmt.Fprintf(bw, "Length%d=%d\n", i+1, seconds(d))
+	}
+	fmt.Fprintf(bw, "NumberOfEntries=%d\n", len(set.Updates))
+	fmt.Fprintln(bw, "Version=2")
+	return bw.Flush()
+}
+
+// seconds converts a duration in milliseconds to whole seconds, keeping -1 for
+// unknown
+func seconds(millis int64) int64 {
+	if millis < 0 {
+		return -1
+	}
+	return millis / 1000
+}
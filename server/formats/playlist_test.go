@@ -0,0 +1,117 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+// testSet started 2024-03-01 21:00:00 UTC
+func testSet() *Set {
+	started := int64(1709326800000)
+	return &Set{
+		UserID:  "test-user",
+		Started: started,
+		Updates: []*trackstar.TrackUpdate{
+			{
+				DeckId: "1",
+				Track:  &trackstar.Track{Artist: "First Artist", Title: "First Title"},
+				When:   started + 5000,
+				Index:  1,
+			},
+			{
+				DeckId: "2",
+				Track:  &trackstar.Track{Artist: "Second Artist", Title: "Second Title"},
+				When:   started + 5000 + 185500,
+				Index:  2,
+			},
+			{
+				DeckId: "1",
+				Track:  &trackstar.Track{Title: "ID"},
+				When:   started + 5000 + 185500 + 240000,
+				Index:  3,
+			},
+		},
+	}
+}
+
+func TestM3U(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeM3U(buf, testSet()))
+	require.Equal(t, `#EXTM3U
+#PLAYLIST:test-user 2024-03-01T21:00:00Z
+#EXTINF:185,First Artist - First Title
+First Artist - First Title
+#EXTINF:240,Second Artist - Second Title
+Second Artist - Second Title
+#EXTINF:-1,ID
+ID
+`, buf.String())
+}
+
+func TestPLS(t *testing.T) {
+	t.Parallel()
+	buf := &bytes.Buffer{}
+	require.NoError(t, writePLS(buf, testSet()))
+	require.Equal(t, `[playlist]
+File1=First Artist - First Title
+Title1=First Artist - First Title
+Length1=185
+File2=Second Artist - Second Title
+Title2=Second Artist - Second Title
+Length2=240
+File3=ID
+Title3=ID
+Length3=-1
+NumberOfEntries=3
+Version=2
+`, buf.String())
+}
+
+// line breaks in track names can't add entries
+func TestPlaylistLineBreaks(t *testing.T) {
+	t.Parallel()
+	set := testSet()
+	set.Updates = set.Updates[:1]
+	set.Updates[0].Track.Artist = "Evil\nhttp://example.com/evil.mp3\r\nFile9=/etc/passwd"
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeM3U(buf, set))
+	require.Equal(t, `#EXTM3U
+#PLAYLIST:test-user 2024-03-01T21:00:00Z
+#EXTINF:-1,Evil http://example.com/evil.mp3 File9=/etc/passwd - First Title
+Evil http://example.com/evil.mp3 File9=/etc/passwd - First Title
+`, buf.String(), "m3u")
+
+	buf.Reset()
+	require.NoError(t, writePLS(buf, set))
+	require.Equal(t, `[playlist]
+File1=Evil http://example.com/evil.mp3 File9=/etc/passwd - First Title
+Title1=Evil http://example.com/evil.mp3 File9=/etc/passwd - First Title
+Length1=-1
+NumberOfEntries=1
+Version=2
+`, buf.String(), "pls")
+}
+
+func TestForAccept(t *testing.T) {
+	t.Parallel()
+	require.Nil(t, ForAccept("text/html,application/xhtml+xml,*/*;q=0.8"))
+	require.Nil(t, ForAccept(""))
+	require.Equal(t, "pls", ForAccept("audio/x-scpls").Name)
+	require.Equal(t, "m3u", ForAccept("application/json;q=0.5, audio/mpegurl").Name)
+	require.Equal(t, "pls", ForAccept("audio/mpegurl;q=0.5, audio/x-scpls").Name, "higher quality wins")
+	require.Equal(t, "m3u", ForAccept("audio/mpegurl, audio/x-scpls").Name, "equal quality keeps order")
+	require.Nil(t, ForAccept("text/plain;q=0.1, application/json"), "JSON preferred")
+	require.Nil(t, ForAccept("audio/x-scpls;q=0"), "not acceptable")
+	require.Nil(t, ForAccept("audio/x-scpls;q=nope"), "bad quality")
+	require.Nil(t, ForAccept("*/*, audio/x-scpls"), "anything, JSON first")
+	require.Nil(t, ForAccept("application/json, text/plain, */*"), "axios")
+	require.Nil(t, ForAccept("text/plain"), "tracklists are only downloaded")
+	require.Equal(t, "m3u8", Lookup("m3u8").Name)
+	require.Nil(t, Lookup("nope"))
+}
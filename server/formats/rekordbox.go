@@ -0,0 +1,117 @@
+package formats
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// rekordbox XML, as imported with File > Import > rekordbox xml. There are no
+// files to point at, so tracks only have the stored metadata.
+
+type rbPlaylists struct {
+	XMLName    xml.Name     `xml:"DJ_PLAYLISTS"`
+	Version    string       `xml:"Version,attr"`
+	Product    rbProduct    `xml:"PRODUCT"`
+	Collection rbCollection `xml:"COLLECTION"`
+	Playlists  rbNode       `xml:"PLAYLISTS>NODE"`
+}
+
+type rbProduct struct {
+	Name    string `xml:"Name,attr"`
+	Version string `xml:"Version,attr"`
+	Company string `xml:"Company,attr"`
+}
+
+type rbCollection struct {
+	Entries int       `xml:"Entries,attr"`
+	Tracks  []rbTrack `xml:"TRACK"`
+}
+
+type rbTrack struct {
+	TrackID   int    `xml:"TrackID,attr"`
+	Name      string `xml:"Name,attr"`
+	Artist    string `xml:"Artist,attr"`
+	TotalTime int64  `xml:"TotalTime,attr,omitempty"`
+	DateAdded string `xml:"DateAdded,attr"`
+	Comments  string `xml:"Comments,attr,omitempty"`
+}
+
+type rbNode struct {
+	Type    int          `xml:"Type,attr"`
+	Name    string       `xml:"Name,attr"`
+	Count   *int         `xml:"Count,attr"`
+	KeyType *int         `xml:"KeyType,attr"`
+	Entries *int         `xml:"Entries,attr"`
+	Nodes   []rbNode     `xml:"NODE"`
+	Tracks  []rbTrackKey `xml:"TRACK"`
+}
+
+type rbTrackKey struct {
+	Key int `xml:"Key,attr"`
+}
+
+func writeRekordbox(w io.Writer, set *Set) error {
+	doc := rbPlaylists{
+		Version: "1.0.0",
+		Product: rbProduct{
+			Name:    "Trackstar Live",
+			Version: "1",
+			Company: "AutonomousKoi",
+		},
+		Collection: rbCollection{
+			Entries: len(set.Updates),
+		},
+	}
+	playlist := rbNode{
+		Type:    1,
+		Name:    playlistName(set),
+		KeyType: ptr(0),
+		Entries: ptr(len(set.Updates)),
+	}
+	for i, d := range durations(set.Updates) {
+		tu := set.Updates[i]
+		trackID := i + 1
+		track := rbTrack{
+			TrackID:   trackID,
+			Name:      tu.GetTrack().GetTitle(),
+			Artist:    tu.GetTrack().GetArtist(),
+			TotalTime: max(seconds(d), 0),
+			DateAdded: time.UnixMilli(tu.GetWhen()).UTC().Format(time.DateOnly),
+		}
+		if deckID := tu.GetDeckId(); deckID != "" {
+			track.Comments = "Deck " + deckID
+		}
+		doc.Collection.Tracks = append(doc.Collection.Tracks, track)
+		playlist.Tracks = append(playlist.Tracks, rbTrackKey{Key: trackID})
+	}
+	doc.Playlists = rbNode{
+		Type:  0,
+		Name:  "ROOT",
+		Count: ptr(1),
+		Nodes: []rbNode{playlist},
+	}
+	return writeXML(w, doc)
+}
+
+// playlistName names the playlist set is exported as
+func playlistName(set *Set) string {
+	return set.UserID + " " + time.UnixMilli(set.Started).UTC().Format("2006-01-02 15:04")
+}
+
+func writeXML(w io.Writer, doc any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,140 @@
+package formats
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+// column is a field of a tabular export
+type column struct {
+	header string
+	value  func(set *Set, tu *trackstar.TrackUpdate, duration int64) string
+}
+
+// columns are the columns tabular exports can have, by the name used to
+// select them
+var columns = map[string]column{
+	"index": {"index", func(_ *Set, tu *trackstar.TrackUpdate, _ int64) string {
+		return strconv.Itoa(int(tu.GetIndex()))
+	}},
+	"when": {"when", func(set *Set, tu *trackstar.TrackUpdate, _ int64) string {
+		return time.UnixMilli(tu.GetWhen()).In(set.location()).Format(time.RFC3339)
+	}},
+	"offset": {"offset", func(set *Set, tu *trackstar.TrackUpdate, _ int64) string {
+		return formatOffset(max(time.Duration(tu.GetWhen()-set.Started+set.Offset)*time.Millisecond, 0), true)
+	}},
+	"duration": {"duration", func(_ *Set, _ *trackstar.TrackUpdate, duration int64) string {
+		if duration < 0 {
+			return ""
+		}
+		return formatOffset(time.Duration(duration)*time.Millisecond, true)
+	}},
+	"deck": {"deck ID", func(_ *Set, tu *trackstar.TrackUpdate, _ int64) string {
+		return tu.GetDeckId()
+	}},
+	"artist": {"artist", func(_ *Set, tu *trackstar.TrackUpdate, _ int64) string {
+		return tu.GetTrack().GetArtist()
+	}},
+	"title": {"title", func(_ *Set, tu *trackstar.TrackUpdate, _ int64) string {
+		return tu.GetTrack().GetTitle()
+	}},
+}
+
+// DefaultColumns are the columns of a tabular export that doesn't select any
+var DefaultColumns = []string{"index", "when", "deck", "artist", "title"}
+
+// ParseColumns parses a comma-separated list of column names
+func ParseColumns(s string) ([]string, error) {
+	var names []string
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no columns")
+	}
+	return names, nil
+}
+
+// delimiters are the delimiters that can be chosen by name
+var delimiters = map[string]rune{
+	"comma":     ',',
+	"semicolon": ';',
+	"tab":       '\t',
+	"pipe":      '|',
+}
+
+// ParseDelimiter parses a CSV delimiter, either a name like semicolon or the
+// character itself
+func ParseDelimiter(s string) (rune, error) {
+	if r, ok := delimiters[strings.ToLower(s)]; ok {
+		return r, nil
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || size != len(s) || r == utf8.RuneError || r == '"' || r == '\r' || r == '\n' {
+		return 0, fmt.Errorf("invalid delimiter %q", s)
+	}
+	return r, nil
+}
+
+func (set *Set) location() *time.Location {
+	if set.Location == nil {
+		return time.UTC
+	}
+	return set.Location
+}
+
+func writeCSV(w io.Writer, set *Set) error {
+	delimiter := set.Delimiter
+	if delimiter == 0 {
+		delimiter = ','
+	}
+	return writeTabular(w, set, delimiter)
+}
+
+func writeTSV(w io.Writer, set *Set) error {
+	return writeTabular(w, set, '\t')
+}
+
+// writeTabular writes a header row and a row per track with set's columns
+func writeTabular(w io.Writer, set *Set, delimiter rune) error {
+	names := set.Columns
+	if len(names) == 0 {
+		names = DefaultColumns
+	}
+	cols := make([]column, len(names))
+	row := make([]string, len(names))
+	for i, name := range names {
+		col, ok := columns[name]
+		if !ok {
+			return fmt.Errorf("unknown column %q", name)
+		}
+		cols[i] = col
+		row[i] = col.header
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = delimiter
+	cw.Write(row)
+	for i, d := range durations(set.Updates) {
+		for j, col := range cols {
+			row[j] = col.value(set, set.Updates[i], d)
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
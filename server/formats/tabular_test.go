@@ -0,0 +1,81 @@
+package formats
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSV(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeCSV(buf, testSet()))
+	require.Equal(t, `index,when,deck ID,artist,title
+1,2024-03-01T21:00:05Z,1,First Artist,First Title
+2,2024-03-01T21:03:10Z,2,Second Artist,Second Title
+3,2024-03-01T21:07:10Z,1,,ID
+`, buf.String(), "defaults")
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	set := testSet()
+	set.Columns = []string{"when", "offset", "duration", "title"}
+	set.Location = loc
+	set.Delimiter = ';'
+	set.Updates[0].Track.Title = "First; Title"
+	buf.Reset()
+	require.NoError(t, writeCSV(buf, set))
+	require.Equal(t, `when;offset;duration;title
+2024-03-01T22:00:05+01:00;00:00:05;00:03:05;"First; Title"
+2024-03-01T22:03:10+01:00;00:03:10;00:04:00;Second Title
+2024-03-01T22:07:10+01:00;00:07:10;;ID
+`, buf.String(), "options")
+
+	set.Columns = []string{"nope"}
+	require.Error(t, writeCSV(buf, set))
+}
+
+func TestTSV(t *testing.T) {
+	t.Parallel()
+	set := testSet()
+	set.Columns = []string{"index", "artist", "title"}
+	set.Delimiter = ';'
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeTSV(buf, set))
+	require.Equal(t, "index\tartist\ttitle\n"+
+		"1\tFirst Artist\tFirst Title\n"+
+		"2\tSecond Artist\tSecond Title\n"+
+		"3\t\tID\n", buf.String(), "delimiter is always tab")
+}
+
+func TestParseColumns(t *testing.T) {
+	t.Parallel()
+	columns, err := ParseColumns(" Offset, title,,artist")
+	require.NoError(t, err)
+	require.Equal(t, []string{"offset", "title", "artist"}, columns)
+	_, err = ParseColumns("title,album")
+	require.Error(t, err)
+	_, err = ParseColumns(",")
+	require.Error(t, err)
+}
+
+func TestParseDelimiter(t *testing.T) {
+	t.Parallel()
+	for input, want := range map[string]rune{
+		"semicolon": ';',
+		"TAB":       '\t',
+		";":         ';',
+		"|":         '|',
+	} {
+		got, err := ParseDelimiter(input)
+		require.NoError(t, err, input)
+		require.Equal(t, want, got, input)
+	}
+	for _, input := range []string{"", `"`, "\n", ";;"} {
+		_, err := ParseDelimiter(input)
+		require.Error(t, err, input)
+	}
+}
@@ -0,0 +1,97 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTemplate is how a tracklist line is written when a set has no
+// template
+const DefaultTemplate = "{offset} {name}"
+
+// MaxTemplateLength is the longest template ParseTemplate accepts, in bytes
+const MaxTemplateLength = 256
+
+// Placeholders are replaced in a tracklist template with each track's values.
+// {offset} is when the track started relative to the set, e.g. 12:34 or
+// 01:12:34 for sets over an hour, and {name} is the artist and title together.
+var Placeholders = []string{"{offset}", "{index}", "{deck}", "{artist}", "{title}", "{name}"}
+
+// placeholderRE matches anything that looks like a placeholder
+var placeholderRE = regexp.MustCompile(`\{[A-Za-z_]*\}`)
+
+// Template is a tracklist line with Placeholders in it. Templates aren't
+// programs, they're only ever substituted into, so they're safe to take from
+// anyone.
+type Template struct {
+	text string
+}
+
+var defaultTemplate = &Template{text: DefaultTemplate}
+
+// ParseTemplate parses text as a tracklist line template, checking that it
+// isn't too long and only has known placeholders
+func ParseTemplate(text string) (*Template, error) {
+	if len(text) > MaxTemplateLength {
+		return nil, fmt.Errorf("longer than %d bytes", MaxTemplateLength)
+	}
+	for _, placeholder := range placeholderRE.FindAllString(text, -1) {
+		if !slices.Contains(Placeholders, placeholder) {
+			return nil, fmt.Errorf("unknown placeholder %s", placeholder)
+		}
+	}
+	return &Template{text: text}, nil
+}
+
+// writeTracklist writes one line per track with its offset into the set, as
+// pasted into YouTube chapters or Mixcloud's tracklist. YouTube only accepts
+// chapters that start at 00:00, so the first track is always there and the
+// set's offset only moves the rest.
+func writeTracklist(w io.Writer, set *Set) error {
+	tmpl := set.Template
+	if tmpl == nil {
+		tmpl = defaultTemplate
+	}
+	offsets := make([]time.Duration, len(set.Updates))
+	var last time.Duration
+	for i, tu := range set.Updates {
+		if i == 0 {
+			continue
+		}
+		offsets[i] = max(time.Duration(tu.GetWhen()-set.Started+set.Offset)*time.Millisecond, 0)
+		last = max(last, offsets[i])
+	}
+	bw := bufio.NewWriter(w)
+	for i, tu := range set.Updates {
+		// replaced values aren't searched for placeholders, so track names
+		// can't inject any
+		line := strings.NewReplacer(
+			"{offset}", formatOffset(offsets[i], last >= time.Hour),
+			"{index}", strconv.Itoa(int(tu.GetIndex())),
+			"{deck}", tu.GetDeckId(),
+			"{artist}", tu.GetTrack().GetArtist(),
+			"{title}", tu.GetTrack().GetTitle(),
+			"{name}", displayName(tu),
+		).Replace(tmpl.text)
+		// a line per track, whatever's in the template or the track
+		line = strings.TrimRight(line, "\r\n")
+		bw.WriteString(oneLine(line))
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// formatOffset formats d as mm:ss, or hh:mm:ss if hours is set
+func formatOffset(d time.Duration, hours bool) string {
+	secs := int64(d / time.Second)
+	if hours {
+		return fmt.Sprintf("%02d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+	}
+	return fmt.Sprintf("%02d:%02d", secs/60, secs%60)
+}
@@ -0,0 +1,73 @@
+package formats
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracklist(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeTracklist(buf, testSet()))
+	require.Equal(t, `00:00 First Artist - First Title
+03:10 Second Artist - Second Title
+07:10 ID
+`, buf.String(), "default")
+
+	set := testSet()
+	set.Offset = -10000
+	tmpl, err := ParseTemplate("{offset} [{deck}] {title} by {artist}")
+	require.NoError(t, err)
+	set.Template = tmpl
+	buf.Reset()
+	require.NoError(t, writeTracklist(buf, set))
+	require.Equal(t, `00:00 [1] First Title by First Artist
+03:00 [2] Second Title by Second Artist
+07:00 [1] ID by 
+`, buf.String(), "offset and template")
+
+	set = testSet()
+	set.Offset = int64(time.Hour / time.Millisecond)
+	buf.Reset()
+	require.NoError(t, writeTracklist(buf, set))
+	require.Equal(t, `00:00:00 First Artist - First Title
+01:03:10 Second Artist - Second Title
+01:07:10 ID
+`, buf.String(), "over an hour")
+}
+
+func TestParseTemplate(t *testing.T) {
+	t.Parallel()
+	_, err := ParseTemplate("{album}")
+	require.Error(t, err, "unknown placeholder")
+	_, err = ParseTemplate(strings.Repeat("x", MaxTemplateLength+1))
+	require.Error(t, err, "too long")
+	_, err = ParseTemplate("{{range 2000000000}}{{end}}")
+	require.ErrorContains(t, err, "unknown placeholder {end}", "not a program")
+	tmpl, err := ParseTemplate("{{offset}} {not a placeholder}")
+	require.NoError(t, err, "braces are just text")
+	require.Equal(t, "{{offset}} {not a placeholder}", tmpl.text)
+	_, err = ParseTemplate(DefaultTemplate)
+	require.NoError(t, err)
+}
+
+func TestTracklistInjection(t *testing.T) {
+	t.Parallel()
+
+	set := testSet()
+	set.Updates[0].Track.Artist = "{title}\nEvil"
+	tmpl, err := ParseTemplate("{index}. {artist}\n")
+	require.NoError(t, err)
+	set.Template = tmpl
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeTracklist(buf, set))
+	require.Equal(t, `1. {title} Evil
+2. Second Artist
+3. 
+`, buf.String())
+}
@@ -0,0 +1,175 @@
+package formats
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+// Traktor NML, as imported with Import Playlist. Entries are written like
+// Traktor's own history playlists, with when each track started and on which
+// deck. There are no files, so each entry gets a location under traktorDir
+// for the playlist to refer to it by.
+
+const traktorDir = "/:Trackstar Live/:"
+
+type nml struct {
+	XMLName      xml.Name      `xml:"NML"`
+	Version      string        `xml:"VERSION,attr"`
+	Head         nmlHead       `xml:"HEAD"`
+	MusicFolders struct{}      `xml:"MUSICFOLDERS"`
+	Collection   nmlCollection `xml:"COLLECTION"`
+	Playlists    nmlNode       `xml:"PLAYLISTS>NODE"`
+}
+
+type nmlHead struct {
+	Company string `xml:"COMPANY,attr"`
+	Program string `xml:"PROGRAM,attr"`
+}
+
+type nmlCollection struct {
+	Entries int        `xml:"ENTRIES,attr"`
+	Entry   []nmlEntry `xml:"ENTRY"`
+}
+
+type nmlEntry struct {
+	Title    string      `xml:"TITLE,attr"`
+	Artist   string      `xml:"ARTIST,attr"`
+	Location nmlLocation `xml:"LOCATION"`
+	Info     nmlInfo     `xml:"INFO"`
+}
+
+type nmlLocation struct {
+	Dir      string `xml:"DIR,attr"`
+	File     string `xml:"FILE,attr"`
+	Volume   string `xml:"VOLUME,attr"`
+	VolumeID string `xml:"VOLUMEID,attr"`
+}
+
+type nmlInfo struct {
+	Comment  string `xml:"COMMENT,attr,omitempty"`
+	Playtime int64  `xml:"PLAYTIME,attr,omitempty"`
+}
+
+type nmlNode struct {
+	Type     string       `xml:"TYPE,attr"`
+	Name     string       `xml:"NAME,attr"`
+	Subnodes *nmlSubnodes `xml:"SUBNODES"`
+	Playlist *nmlPlaylist `xml:"PLAYLIST"`
+}
+
+type nmlSubnodes struct {
+	Count int       `xml:"COUNT,attr"`
+	Nodes []nmlNode `xml:"NODE"`
+}
+
+type nmlPlaylist struct {
+	Entries int                `xml:"ENTRIES,attr"`
+	Type    string             `xml:"TYPE,attr"`
+	UUID    string             `xml:"UUID,attr"`
+	Entry   []nmlPlaylistEntry `xml:"ENTRY"`
+}
+
+type nmlPlaylistEntry struct {
+	PrimaryKey   nmlPrimaryKey   `xml:"PRIMARYKEY"`
+	ExtendedData nmlExtendedData `xml:"EXTENDEDDATA"`
+}
+
+type nmlPrimaryKey struct {
+	Type string `xml:"TYPE,attr"`
+	Key  string `xml:"KEY,attr"`
+}
+
+type nmlExtendedData struct {
+	Deck         string `xml:"DECK,attr,omitempty"`
+	Duration     string `xml:"DURATION,attr,omitempty"`
+	ExtendedType string `xml:"EXTENDEDTYPE,attr"`
+	PlayedPublic int    `xml:"PLAYEDPUBLIC,attr"`
+	StartDate    int    `xml:"STARTDATE,attr"`
+	StartTime    int    `xml:"STARTTIME,attr"`
+}
+
+func writeNML(w io.Writer, set *Set) error {
+	doc := nml{
+		Version: "19",
+		Head: nmlHead{
+			Company: "www.native-instruments.com",
+			Program: "Traktor",
+		},
+		Collection: nmlCollection{
+			Entries: len(set.Updates),
+		},
+	}
+	playlist := &nmlPlaylist{
+		Entries: len(set.Updates),
+		Type:    "LIST",
+		UUID:    traktorUUID(set),
+	}
+	for i, d := range durations(set.Updates) {
+		tu := set.Updates[i]
+		file := traktorFile(i, tu)
+		entry := nmlEntry{
+			Title:  tu.GetTrack().GetTitle(),
+			Artist: tu.GetTrack().GetArtist(),
+			Location: nmlLocation{
+				Dir:  traktorDir,
+				File: file,
+			},
+			Info: nmlInfo{
+				Playtime: max(seconds(d), 0),
+			},
+		}
+		when := time.UnixMilli(tu.GetWhen()).UTC()
+		extended := nmlExtendedData{
+			ExtendedType: "HistoryData",
+			PlayedPublic: 1,
+			StartDate:    when.Year()<<16 | int(when.Month())<<8 | when.Day(),
+			StartTime:    when.Hour()*3600 + when.Minute()*60 + when.Second(),
+		}
+		if deckID := tu.GetDeckId(); deckID != "" {
+			entry.Info.Comment = "Deck " + deckID
+			extended.Deck = deckID
+		}
+		if d >= 0 {
+			extended.Duration = strconv.FormatFloat(float64(d)/1000, 'f', -1, 64)
+		}
+		doc.Collection.Entry = append(doc.Collection.Entry, entry)
+		playlist.Entry = append(playlist.Entry, nmlPlaylistEntry{
+			PrimaryKey: nmlPrimaryKey{
+				Type: "TRACK",
+				Key:  traktorDir + file,
+			},
+			ExtendedData: extended,
+		})
+	}
+	doc.Playlists = nmlNode{
+		Type: "FOLDER",
+		Name: "$ROOT",
+		Subnodes: &nmlSubnodes{
+			Count: 1,
+			Nodes: []nmlNode{{
+				Type:     "PLAYLIST",
+				Name:     playlistName(set),
+				Playlist: playlist,
+			}},
+		},
+	}
+	return writeXML(w, doc)
+}
+
+// traktorFile is the file name for the ith update. Traktor identifies tracks
+// by location, so it includes the position to keep repeats apart.
+func traktorFile(i int, tu *trackstar.TrackUpdate) string {
+	return strconv.Itoa(i+1) + " " + displayName(tu)
+}
+
+// traktorUUID is stable for set, so importing it again replaces the playlist
+func traktorUUID(set *Set) string {
+	sum := sha256.Sum256([]byte(set.UserID + "/" + strconv.FormatInt(set.Started, 10)))
+	return hex.EncodeToString(sum[:16])
+}
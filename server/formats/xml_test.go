@@ -0,0 +1,39 @@
+package formats
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// requireGolden checks got against testdata/name, or writes it with -update
+func requireGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.WriteFile(golden, got, 0644))
+	}
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got))
+}
+
+func TestGolden(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"rekordbox", "nml"} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			f := Lookup(name)
+			require.NotNil(t, f)
+			buf := &bytes.Buffer{}
+			require.NoError(t, f.Write(buf, testSet()))
+			requireGolden(t, "set."+f.Extension, buf.Bytes())
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Package history parses the play history DJ software keeps into sessions that
+// can be imported. Serato history CSVs, rekordbox XML history playlists and
+// Traktor history NMLs are supported.
+package history
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"time"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+// Formats parsed
+const (
+	FormatSerato    = "serato"
+	FormatRekordbox = "rekordbox"
+	FormatTraktor   = "traktor"
+)
+
+var (
+	// ErrUnknownFormat is returned when a file isn't a history file that can be
+	// parsed
+	ErrUnknownFormat = errors.New("unknown history format")
+	// ErrNoSessions is returned when a history file has no played tracks
+	ErrNoSessions = errors.New("no sessions found")
+)
+
+// Session is a set from a history file. Times are in milliseconds.
+type Session struct {
+	// Name is what the DJ software called the session, if anything
+	Name    string
+	Started int64
+	Updates []*trackstar.TrackUpdate
+	// Estimated is set when the file doesn't say when tracks were played and
+	// they've been estimated from track lengths
+	Estimated bool
+}
+
+// Import is what was parsed from a history file
+type Import struct {
+	Format   string
+	Sessions []*Session
+}
+
+// Parse detects the format of a history file and parses it. Times in the file
+// without a time zone are in loc.
+func Parse(b []byte, loc *time.Location) (*Import, error) {
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	format, err := detect(b)
+	if err != nil {
+		return nil, err
+	}
+	var sessions []*Session
+	switch format {
+	case FormatSerato:
+		sessions, err = parseSerato(b, loc)
+	case FormatRekordbox:
+		sessions, err = parseRekordbox(b, loc)
+	case FormatTraktor:
+		sessions, err = parseTraktor(b, loc)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, ErrNoSessions
+	}
+	for _, s := range sessions {
+		for i, tu := range s.Updates {
+			tu.Index = int32(i + 1)
+		}
+	}
+	return &Import{
+		Format:   format,
+		Sessions: sessions,
+	}, nil
+}
+
+// detect determines the format of b from its content
+func detect(b []byte) (string, error) {
+	trimmed := bytes.TrimSpace(b)
+	if !bytes.HasPrefix(trimmed, []byte("<")) {
+		if isSerato(trimmed) {
+			return FormatSerato, nil
+		}
+		return "", ErrUnknownFormat
+	}
+	dec := xml.NewDecoder(bytes.NewReader(trimmed))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return "", ErrUnknownFormat
+		}
+		if err != nil {
+			return "", ErrUnknownFormat
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			switch start.Name.Local {
+			case "NML":
+				return FormatTraktor, nil
+			case "DJ_PLAYLISTS":
+				return FormatRekordbox, nil
+			}
+			return "", ErrUnknownFormat
+		}
+	}
+}
+
+func newUpdate(deckID, artist, title string, when time.Time) *trackstar.TrackUpdate {
+	return &trackstar.TrackUpdate{
+		DeckId: deckID,
+		Track: &trackstar.Track{
+			Artist: artist,
+			Title:  title,
+		},
+		When: when.UnixMilli(),
+	}
+}
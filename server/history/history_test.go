@@ -0,0 +1,205 @@
+package history_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonomouskoi/trackstar-live/server/formats"
+	"github.com/autonomouskoi/trackstar-live/server/history"
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+type track struct {
+	Index  int32
+	Deck   string
+	Artist string
+	Title  string
+	When   string
+}
+
+type session struct {
+	Name      string
+	Started   string
+	Estimated bool
+	Tracks    []track
+}
+
+func fmtTime(millis int64) string {
+	return time.UnixMilli(millis).UTC().Format(time.DateTime)
+}
+
+// summarize makes sessions easy to compare
+func summarize(sessions []*history.Session) []session {
+	var summaries []session
+	for _, s := range sessions {
+		summary := session{
+			Name:      s.Name,
+			Started:   fmtTime(s.Started),
+			Estimated: s.Estimated,
+		}
+		for _, tu := range s.Updates {
+			summary.Tracks = append(summary.Tracks, track{
+				Index:  tu.GetIndex(),
+				Deck:   tu.GetDeckId(),
+				Artist: tu.GetTrack().GetArtist(),
+				Title:  tu.GetTrack().GetTitle(),
+				When:   fmtTime(tu.GetWhen()),
+			})
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func parseFile(t *testing.T, name string) *history.Import {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	imp, err := history.Parse(b, time.UTC)
+	require.NoError(t, err)
+	return imp
+}
+
+func TestSerato(t *testing.T) {
+	t.Parallel()
+	imp := parseFile(t, "serato.csv")
+	require.Equal(t, history.FormatSerato, imp.Format)
+	require.Equal(t, []session{{
+		Name:    "2024-03-01",
+		Started: "2024-03-01 23:50:00",
+		Tracks: []track{
+			{Index: 1, Deck: "1", Artist: "First Artist", Title: "First Title", When: "2024-03-01 23:50:05"},
+			{Index: 2, Deck: "2", Artist: "Second Artist", Title: "Second, Title", When: "2024-03-01 23:56:00"},
+			{Index: 3, Deck: "1", Artist: "Third Artist", Title: "Third Title", When: "2024-03-02 00:04:00"},
+		},
+	}}, summarize(imp.Sessions))
+}
+
+func TestRekordbox(t *testing.T) {
+	t.Parallel()
+	imp := parseFile(t, "rekordbox.xml")
+	require.Equal(t, history.FormatRekordbox, imp.Format)
+	require.Equal(t, []session{
+		{
+			Name:      "HISTORY 2024-03-01",
+			Started:   "2024-03-01 00:00:00",
+			Estimated: true,
+			Tracks: []track{
+				{Index: 1, Artist: "First Artist", Title: "First Title", When: "2024-03-01 00:00:00"},
+				{Index: 2, Artist: "Second Artist", Title: "Second Title", When: "2024-03-01 00:05:00"},
+			},
+		},
+		{
+			Name:      "HISTORY 2024-03-01 (2)",
+			Started:   "2024-03-01 00:00:00",
+			Estimated: true,
+			Tracks: []track{
+				{Index: 1, Artist: "Third Artist", Title: "Third Title", When: "2024-03-01 00:00:00"},
+			},
+		},
+	}, summarize(imp.Sessions))
+	require.Equal(t, int64(1), imp.Sessions[1].Started-imp.Sessions[0].Started, "later playlists are kept apart")
+}
+
+func TestRekordboxNoLength(t *testing.T) {
+	t.Parallel()
+	b := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<DJ_PLAYLISTS Version="1.0.0">
+  <PRODUCT Name="rekordbox" Version="6.8.4" Company="AlphaTheta"/>
+  <COLLECTION Entries="2">
+    <TRACK TrackID="1" Name="First Title" Artist="First Artist" TotalTime="0"/>
+    <TRACK TrackID="2" Name="Second Title" Artist="Second Artist"/>
+    <TRACK TrackID="3" Name="Third Title" Artist="Third Artist" TotalTime="300"/>
+  </COLLECTION>
+  <PLAYLISTS>
+    <NODE Type="0" Name="ROOT" Count="1">
+      <NODE Name="HISTORY 2024-03-01" Type="1" KeyType="0" Entries="3">
+        <TRACK Key="1"/>
+        <TRACK Key="2"/>
+        <TRACK Key="3"/>
+      </NODE>
+    </NODE>
+  </PLAYLISTS>
+</DJ_PLAYLISTS>`)
+	imp, err := history.Parse(b, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, imp.Sessions, 1)
+	var whens []string
+	for _, tu := range imp.Sessions[0].Updates {
+		whens = append(whens, time.UnixMilli(tu.GetWhen()).UTC().Format(time.TimeOnly))
+	}
+	require.Equal(t, []string{"00:00:00", "00:00:01", "00:00:02"}, whens, "tracks without a length keep distinct times")
+}
+
+func TestTraktor(t *testing.T) {
+	t.Parallel()
+	imp := parseFile(t, "traktor.nml")
+	require.Equal(t, history.FormatTraktor, imp.Format)
+	require.Equal(t, []session{{
+		Name:    "History 2024-03-01",
+		Started: "2024-03-01 21:00:05",
+		Tracks: []track{
+			{Index: 1, Deck: "0", Artist: "First Artist", Title: "First Title", When: "2024-03-01 21:00:05"},
+			{Index: 2, Deck: "1", Artist: "Second Artist", Title: "Second Title", When: "2024-03-01 21:05:05"},
+		},
+	}}, summarize(imp.Sessions))
+}
+
+func TestTimeZone(t *testing.T) {
+	t.Parallel()
+	b, err := os.ReadFile(filepath.Join("testdata", "traktor.nml"))
+	require.NoError(t, err)
+	imp, err := history.Parse(b, time.FixedZone("UTC-5", -5*3600))
+	require.NoError(t, err)
+	require.Equal(t, "2024-03-02 02:00:05", fmtTime(imp.Sessions[0].Started))
+}
+
+// sets exported by formats can be imported again
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	started := int64(1709326800000)
+	set := &formats.Set{
+		UserID:  "test-user",
+		Started: started,
+		Updates: []*trackstar.TrackUpdate{
+			{DeckId: "1", Track: &trackstar.Track{Artist: "First Artist", Title: "First Title"}, When: started + 5000},
+			{DeckId: "2", Track: &trackstar.Track{Artist: "Second Artist", Title: "Second Title"}, When: started + 185000},
+			{DeckId: "1", Track: &trackstar.Track{Title: "ID"}, When: started + 425000},
+		},
+	}
+	for _, name := range []string{"nml", "rekordbox"} {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, formats.Lookup(name).Write(buf, set))
+			imp, err := history.Parse(buf.Bytes(), time.UTC)
+			require.NoError(t, err)
+			require.Len(t, imp.Sessions, 1)
+			updates := imp.Sessions[0].Updates
+			require.Len(t, updates, len(set.Updates))
+			for i, want := range set.Updates {
+				require.Equal(t, want.GetDeckId(), updates[i].GetDeckId())
+				require.Equal(t, want.GetTrack().GetArtist(), updates[i].GetTrack().GetArtist())
+				require.Equal(t, want.GetTrack().GetTitle(), updates[i].GetTrack().GetTitle())
+			}
+			if name == "nml" {
+				require.Equal(t, started+5000, imp.Sessions[0].Started)
+				require.Equal(t, started+425000, updates[2].GetWhen())
+			}
+		})
+	}
+}
+
+func TestUnknown(t *testing.T) {
+	t.Parallel()
+	for _, input := range []string{"", "hello", `<?xml version="1.0"?><rss></rss>`, "a,b,c\n1,2,3\n"} {
+		_, err := history.Parse([]byte(input), time.UTC)
+		require.ErrorIs(t, err, history.ErrUnknownFormat, input)
+	}
+	_, err := history.Parse([]byte("name,artist,start time\n"), time.UTC)
+	require.ErrorIs(t, err, history.ErrNoSessions)
+}
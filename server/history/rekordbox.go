@@ -0,0 +1,173 @@
+package history
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rekordbox XML has history playlists, named for their date, but doesn't say
+// when tracks were played. They're estimated from the date and track lengths.
+
+type rbPlaylists struct {
+	Tracks    []rbTrack `xml:"COLLECTION>TRACK"`
+	Playlists rbNode    `xml:"PLAYLISTS>NODE"`
+}
+
+type rbTrack struct {
+	TrackID   string `xml:"TrackID,attr"`
+	Location  string `xml:"Location,attr"`
+	Name      string `xml:"Name,attr"`
+	Artist    string `xml:"Artist,attr"`
+	TotalTime int64  `xml:"TotalTime,attr"`
+	DateAdded string `xml:"DateAdded,attr"`
+	Comments  string `xml:"Comments,attr"`
+}
+
+type rbNode struct {
+	Type    int          `xml:"Type,attr"`
+	Name    string       `xml:"Name,attr"`
+	KeyType int          `xml:"KeyType,attr"`
+	Nodes   []rbNode     `xml:"NODE"`
+	Tracks  []rbTrackKey `xml:"TRACK"`
+}
+
+type rbTrackKey struct {
+	Key string `xml:"Key,attr"`
+}
+
+const (
+	rbNodeFolder   = 0
+	rbNodePlaylist = 1
+
+	rbKeyTrackID  = 0
+	rbKeyLocation = 1
+)
+
+var (
+	// rbPlaylistDate matches the date and optional time in a playlist name
+	rbPlaylistDate = regexp.MustCompile(`(\d{4}-\d{2}-\d{2})(?: (\d{2}:\d{2}))?`)
+	// rbPlaylistNumber matches the number rekordbox gives a day's second and
+	// later history playlists
+	rbPlaylistNumber = regexp.MustCompile(`\((\d+)\)\s*$`)
+)
+
+func parseRekordbox(b []byte, loc *time.Location) ([]*Session, error) {
+	var doc rbPlaylists
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("parsing rekordbox XML: %w", err)
+	}
+	byID := map[string]*rbTrack{}
+	byLocation := map[string]*rbTrack{}
+	for i := range doc.Tracks {
+		t := &doc.Tracks[i]
+		byID[t.TrackID] = t
+		if t.Location != "" {
+			byLocation[t.Location] = t
+		}
+	}
+
+	// only the history playlists if there are any, otherwise every playlist
+	playlists := rbFind(&doc.Playlists, "HISTORY")
+	if playlists == nil {
+		playlists = &doc.Playlists
+	}
+	var sessions []*Session
+	var err error
+	rbWalk(playlists, func(node *rbNode) {
+		if err != nil {
+			return
+		}
+		tracks := make([]*rbTrack, 0, len(node.Tracks))
+		for _, key := range node.Tracks {
+			t := byID[key.Key]
+			if node.KeyType == rbKeyLocation {
+				t = byLocation[key.Key]
+			}
+			if t != nil {
+				tracks = append(tracks, t)
+			}
+		}
+		if len(tracks) == 0 {
+			return
+		}
+		var started time.Time
+		if started, err = rbStarted(node.Name, tracks[0], loc); err != nil {
+			return
+		}
+		session := &Session{
+			Name:      node.Name,
+			Started:   started.UnixMilli(),
+			Estimated: true,
+		}
+		when := started
+		for _, t := range tracks {
+			// rekordbox doesn't record decks, but the exporter in formats
+			// puts them in comments
+			var deckID string
+			if d, ok := strings.CutPrefix(t.Comments, "Deck "); ok {
+				deckID = d
+			}
+			session.Updates = append(session.Updates, newUpdate(deckID, t.Artist, t.Name, when))
+			// streamed tracks can have no length, but tracks with the same
+			// time would be dropped as duplicates
+			when = when.Add(time.Duration(max(t.TotalTime, 1)) * time.Second)
+		}
+		sessions = append(sessions, session)
+	})
+	return sessions, err
+}
+
+// rbStarted is when a history playlist started, from the date in its name or
+// else when its first track was added. The number of a day's later playlists
+// is added in milliseconds, to keep them apart.
+func rbStarted(name string, first *rbTrack, loc *time.Location) (time.Time, error) {
+	var started time.Time
+	var err error
+	if m := rbPlaylistDate.FindStringSubmatch(name); m != nil {
+		if m[2] != "" {
+			started, err = time.ParseInLocation("2006-01-02 15:04", m[1]+" "+m[2], loc)
+		} else {
+			started, err = time.ParseInLocation(time.DateOnly, m[1], loc)
+		}
+	} else if first.DateAdded != "" {
+		started, err = time.ParseInLocation(time.DateOnly, first.DateAdded, loc)
+	} else {
+		err = fmt.Errorf("no date")
+	}
+	if err != nil {
+		return started, fmt.Errorf("dating playlist %q: %w", name, err)
+	}
+	if m := rbPlaylistNumber.FindStringSubmatch(name); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		started = started.Add(time.Duration(n-1) * time.Millisecond)
+	}
+	return started, nil
+}
+
+// rbFind finds the folder named name
+func rbFind(node *rbNode, name string) *rbNode {
+	if node.Type == rbNodeFolder && node.Name == name {
+		return node
+	}
+	for i := range node.Nodes {
+		if found := rbFind(&node.Nodes[i], name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// rbWalk calls fn with each playlist under node
+func rbWalk(node *rbNode, fn func(*rbNode)) {
+	if node.Type == rbNodePlaylist {
+		fn(node)
+		return
+	}
+	for i := range node.Nodes {
+		rbWalk(&node.Nodes[i], fn)
+	}
+}
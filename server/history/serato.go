@@ -0,0 +1,146 @@
+package history
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Serato exports a session's history as CSV. The first row after the header
+// is usually the session itself, with its date, and tracks may only have a
+// time of day.
+
+var (
+	seratoDateTimeLayouts = []string{
+		"01/02/2006 15:04:05",
+		"1/2/2006 3:04:05 PM",
+		"2006-01-02 15:04:05",
+	}
+	seratoTimeLayouts = []string{
+		"15:04:05",
+		"3:04:05 PM",
+	}
+)
+
+func seratoReader(b []byte) *csv.Reader {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+	return r
+}
+
+// isSerato reports whether b starts with a Serato history header
+func isSerato(b []byte) bool {
+	header, err := seratoReader(b).Read()
+	if err != nil {
+		return false
+	}
+	_, err = seratoColumns(header)
+	return err == nil
+}
+
+type seratoColumnIndexes struct {
+	name, artist, startTime, deck int
+}
+
+func seratoColumns(header []string) (*seratoColumnIndexes, error) {
+	cols := &seratoColumnIndexes{name: -1, artist: -1, startTime: -1, deck: -1}
+	for i, h := range header {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "name":
+			cols.name = i
+		case "artist":
+			cols.artist = i
+		case "start time":
+			cols.startTime = i
+		case "deck":
+			cols.deck = i
+		}
+	}
+	if cols.name == -1 || cols.startTime == -1 {
+		return nil, fmt.Errorf("missing name or start time column")
+	}
+	return cols, nil
+}
+
+func parseSerato(b []byte, loc *time.Location) ([]*Session, error) {
+	records, err := seratoReader(b).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading Serato CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	cols, err := seratoColumns(records[0])
+	if err != nil {
+		return nil, err
+	}
+	field := func(record []string, i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	session := &Session{}
+	var last time.Time
+	for n, record := range records[1:] {
+		line := n + 2
+		startStr := field(record, cols.startTime)
+		if startStr == "" {
+			continue
+		}
+		when, hasDate, err := parseSeratoTime(startStr, loc)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if !hasDate {
+			if last.IsZero() {
+				return nil, fmt.Errorf("line %d: time without a date", line)
+			}
+			y, m, d := last.Date()
+			when = time.Date(y, m, d, when.Hour(), when.Minute(), when.Second(), 0, loc)
+			// past midnight
+			if when.Before(last) {
+				when = when.AddDate(0, 0, 1)
+			}
+		}
+		artist := field(record, cols.artist)
+		// the session row has the session's start with a date and no artist
+		if n == 0 && hasDate && artist == "" && field(record, cols.deck) == "" {
+			session.Name = field(record, cols.name)
+			session.Started = when.UnixMilli()
+			last = when
+			continue
+		}
+		session.Updates = append(session.Updates,
+			newUpdate(field(record, cols.deck), artist, field(record, cols.name), when),
+		)
+		last = when
+	}
+	if len(session.Updates) == 0 {
+		return nil, nil
+	}
+	if session.Started == 0 {
+		session.Started = session.Updates[0].GetWhen()
+	}
+	return []*Session{session}, nil
+}
+
+// parseSeratoTime parses s as a date and time, or a time of day
+func parseSeratoTime(s string, loc *time.Location) (time.Time, bool, error) {
+	for _, layout := range seratoDateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, true, nil
+		}
+	}
+	for _, layout := range seratoTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, false, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("unrecognized time %q", s)
+}
@@ -0,0 +1,93 @@
+package history
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// Traktor keeps each session's history as a playlist whose entries say when
+// and on which deck each track was played. Tracks are in the collection, keyed
+// by their location.
+
+type nml struct {
+	Entries   []nmlEntry `xml:"COLLECTION>ENTRY"`
+	Playlists nmlNode    `xml:"PLAYLISTS>NODE"`
+}
+
+type nmlEntry struct {
+	Title    string `xml:"TITLE,attr"`
+	Artist   string `xml:"ARTIST,attr"`
+	Location struct {
+		Dir    string `xml:"DIR,attr"`
+		File   string `xml:"FILE,attr"`
+		Volume string `xml:"VOLUME,attr"`
+	} `xml:"LOCATION"`
+}
+
+type nmlNode struct {
+	Type     string    `xml:"TYPE,attr"`
+	Name     string    `xml:"NAME,attr"`
+	Nodes    []nmlNode `xml:"SUBNODES>NODE"`
+	Playlist *struct {
+		Entries []nmlPlaylistEntry `xml:"ENTRY"`
+	} `xml:"PLAYLIST"`
+}
+
+type nmlPlaylistEntry struct {
+	PrimaryKey struct {
+		Key string `xml:"KEY,attr"`
+	} `xml:"PRIMARYKEY"`
+	ExtendedData *struct {
+		Deck      string `xml:"DECK,attr"`
+		StartDate int    `xml:"STARTDATE,attr"`
+		StartTime int    `xml:"STARTTIME,attr"`
+	} `xml:"EXTENDEDDATA"`
+}
+
+func parseTraktor(b []byte, loc *time.Location) ([]*Session, error) {
+	var doc nml
+	if err := xml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("parsing Traktor NML: %w", err)
+	}
+	byKey := map[string]*nmlEntry{}
+	for i := range doc.Entries {
+		e := &doc.Entries[i]
+		byKey[e.Location.Volume+e.Location.Dir+e.Location.File] = e
+	}
+
+	var sessions []*Session
+	var walk func(node *nmlNode)
+	walk = func(node *nmlNode) {
+		for i := range node.Nodes {
+			walk(&node.Nodes[i])
+		}
+		if node.Playlist == nil {
+			return
+		}
+		session := &Session{Name: node.Name}
+		for _, pe := range node.Playlist.Entries {
+			ext := pe.ExtendedData
+			// only history entries say when they were played
+			if ext == nil || ext.StartDate == 0 {
+				continue
+			}
+			var artist, title string
+			if e := byKey[pe.PrimaryKey.Key]; e != nil {
+				artist, title = e.Artist, e.Title
+			}
+			when := time.Date(
+				ext.StartDate>>16, time.Month(ext.StartDate>>8&0xff), ext.StartDate&0xff,
+				0, 0, ext.StartTime, 0, loc,
+			)
+			session.Updates = append(session.Updates, newUpdate(ext.Deck, artist, title, when))
+		}
+		if len(session.Updates) == 0 {
+			return
+		}
+		session.Started = session.Updates[0].GetWhen()
+		sessions = append(sessions, session)
+	}
+	walk(&doc.Playlists)
+	return sessions, nil
+}
@@ -2,8 +2,12 @@ import type { TrackUpdate } from "/tspb/trackstar_pb.js";
 
 type setCB = (setID: number) => void;
 
+// a set shared with a signed link, viewed without listing the user's sets
+type Share = { setID: number, query: string };
+
 interface ControllerArgs {
     userID: string,
+    share?: Share,
     goodSet: setCB,
     badSet: setCB,
     tracksLoaded: (updates: TrackUpdate[]) => void,
@@ -12,6 +16,7 @@ interface ControllerArgs {
 
 class Controller {
     private _user: string;
+    private _query = '';
     private _sets: Promise<number[]>;
     private _goodSet: setCB;
     private _badSet: setCB;
@@ -19,13 +24,19 @@ class Controller {
     private _tracksLoaded: (updates: TrackUpdate[]) => void;
     private _rt: RT;
 
-    constructor({ userID, goodSet, badSet, tracksLoaded, newTrack }: ControllerArgs) {
+    constructor({ userID, share, goodSet, badSet, tracksLoaded, newTrack }: ControllerArgs) {
         this._user = userID;
-        this._sets = this._listSets();
+        if (share) {
+            this._query = share.query;
+            this._latestSet = share.setID;
+            this._sets = Promise.resolve([share.setID]);
+        } else {
+            this._sets = this._listSets();
+        }
         this._goodSet = goodSet;
         this._badSet = badSet;
         this._tracksLoaded = tracksLoaded;
-        this._rt = new RT(userID, (wrapptedTU) => newTrack(wrapptedTU.update));
+        this._rt = new RT(userID, share, (wrapptedTU) => newTrack(wrapptedTU.update));
         window.addEventListener('popstate', (event) => {
             this.selectSet(event.state, false);
         });
@@ -52,7 +63,7 @@ class Controller {
                 setID = sets[0]
             }
             if (pushState) {
-                history.pushState(setID, setID.toString(), `/u/${this._user}/${setID}`);
+                history.pushState(setID, setID.toString(), `/u/${this._user}/${setID}${this._query}`);
             }
             if (!sets.includes(setID)) {
                 this._badSet(setID);
@@ -64,7 +75,7 @@ class Controller {
 
     private _loadSet(setID: number) {
         this._goodSet(setID);
-        fetch(`/_trackUpdate/${this._user}/${setID}`).then((resp) => resp.json())
+        fetch(`/_trackUpdate/${this._user}/${setID}${this._query}`).then((resp) => resp.json())
             .then((resp: { updates: TrackUpdate[] }) => {
                 if (setID == this._latestSet) {
                     this._rt.connect();
@@ -89,11 +100,16 @@ class RT {
     private _addr: URL;
     private _newTrack: (tu: WrappedTU) => void;
 
-    constructor(userID: string, newTrack = (tu: WrappedTU) => { }) {
+    constructor(userID: string, share?: Share, newTrack = (tu: WrappedTU) => { }) {
         this._newTrack = newTrack;
         this._addr = new URL(document.location.toString());
         this._addr.protocol = this._addr.protocol == 'https:' ? 'wss' : 'ws';
         this._addr.pathname = `/_sub/${userID}`;
+        this._addr.search = '';
+        if (share) {
+            this._addr.search = share.query;
+            this._addr.searchParams.set('started', share.setID.toString());
+        }
     }
 
     connect() {
@@ -126,4 +142,4 @@ class RT {
     }
 }
 
-export { Controller, setCB };
\ No newline at end of file
+export { Controller, Share, setCB };
\ No newline at end of file
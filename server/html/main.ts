@@ -1,4 +1,4 @@
-import { Controller, setCB } from "./controller.js";
+import { Controller, Share, setCB } from "./controller.js";
 import { Current } from "./current.js";
 import { SetsList } from "./sets.js";
 import { TrackList } from "./tracklist.js";
@@ -20,6 +20,24 @@ function start() {
         }
     } finally { }
 
+    // a signed share link grants access to a single set
+    let share: Share;
+    if (setID && new URLSearchParams(window.location.search).has('sig')) {
+        share = { setID, query: window.location.search };
+    }
+
+    // let feed readers find the DJ's feeds. They aren't available with a share link
+    if (!share) {
+        [['feed.xml', 'application/atom+xml'], ['feed.json', 'application/feed+json']].forEach(([file, type]) => {
+            let link = document.createElement('link');
+            link.rel = 'alternate';
+            link.type = type;
+            link.title = `${userID}'s sets`;
+            link.href = `/u/${userID}/${file}`;
+            document.head.appendChild(link);
+        });
+    }
+
     let goodSetCBs: setCB[] = new Array();
     let badSetCBs: setCB[] = new Array();
 
@@ -33,6 +51,7 @@ function start() {
     let h2 = document.querySelector('section.header h2');
     let ctrl = new Controller({
         userID,
+        share,
         goodSet: (setID) => goodSetCBs.forEach((cb) => cb(setID)),
         badSet: (setID) => badSetCBs.forEach((cb) => cb(setID)),
         tracksLoaded: (updates) => tl.tracksLoaded(updates),
@@ -43,10 +62,23 @@ function start() {
     });
 
     goodSetCBs.push((setID: number) => {
+        let jsonQuery = new URLSearchParams(share ? share.query : '').toString();
+        let download = (format: string, label: string): string => {
+            let query = new URLSearchParams(share ? share.query : '');
+            query.set('download', format);
+            return `<a href="/_trackUpdate/${userID}/${setID}?${query}" class="button-link">${label}⇩</a>`;
+        };
         h2.innerHTML = `
 ${new Date(setID).toLocaleString()}
-&nbsp; <a href="/_trackUpdate/${userID}/${setID}?download=csv" class="button-link">CSV⇩</a>
-&nbsp; <a href="/_trackUpdate/${userID}/${setID}" class="button-link" target="_main">JSON⇩</a>
+&nbsp; ${download('csv', 'CSV')}
+&nbsp; ${download('tsv', 'TSV')}
+&nbsp; ${download('m3u8', 'M3U')}
+&nbsp; ${download('pls', 'PLS')}
+&nbsp; ${download('tracklist', 'Tracklist')}
+&nbsp; ${download('rekordbox', 'rekordbox')}
+&nbsp; ${download('nml', 'Traktor')}
+&nbsp; ${download('cue', 'CUE')}
+&nbsp; <a href="/_trackUpdate/${userID}/${setID}${jsonQuery ? '?' + jsonQuery : ''}" class="button-link" target="_main">JSON⇩</a>
 `;
     });
     badSetCBs.push((setID: number) => { h2.innerHTML = `Set not found: ${setID}` });
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/autonomouskoi/trackstar-live/server/history"
+	"github.com/autonomouskoi/trackstar-live/server/store"
+)
+
+// importMaxBytes is the largest history file that can be imported
+const importMaxBytes = 16 << 20
+
+// ImportReport describes what importing a history file stored
+type ImportReport struct {
+	Format     string             `json:"format"`
+	Sessions   []*ImportedSession `json:"sessions"`
+	Imported   int                `json:"imported"`
+	Duplicates int                `json:"duplicates"`
+}
+
+// ImportedSession describes what was stored for one session
+type ImportedSession struct {
+	Name       string `json:"name,omitempty"`
+	Started    int64  `json:"started"`
+	Tracks     int    `json:"tracks"`
+	Imported   int    `json:"imported"`
+	Duplicates int    `json:"duplicates"`
+	// Estimated is set when track times were estimated
+	Estimated bool `json:"estimated,omitempty"`
+}
+
+// ImportHistory stores the sessions in imp for userID. Updates already stored
+// are counted as duplicates, so overlapping imports don't add tracks twice.
+func ImportHistory(ctx context.Context, st Store, userID string, imp *history.Import) (*ImportReport, error) {
+	report := &ImportReport{Format: imp.Format}
+	for _, session := range imp.Sessions {
+		imported := &ImportedSession{
+			Name:      session.Name,
+			Started:   session.Started,
+			Tracks:    len(session.Updates),
+			Estimated: session.Estimated,
+		}
+		report.Sessions = append(report.Sessions, imported)
+		for _, tu := range session.Updates {
+			err := st.AddTrackUpdate(ctx, userID, session.Started, tu)
+			if errors.Is(err, store.ErrDuplicate) {
+				imported.Duplicates++
+				report.Duplicates++
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			imported.Imported++
+			report.Imported++
+		}
+	}
+	return report, nil
+}
+
+// handleImport imports a history file from DJ software. Times in the file are
+// taken to be in the tz parameter's time zone, or UTC.
+func (srv *Server) handleImport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := srv.authenticateOwner(w, r, "import")
+	if !ok {
+		return
+	}
+	loc := time.UTC
+	// the body is the file, so tz mustn't be looked for in a form body
+	if tz := r.URL.Query().Get("tz"); tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			http.Error(w, "invalid tz: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	b, err := io.ReadAll(http.MaxBytesReader(w, r.Body, importMaxBytes))
+	if err != nil {
+		http.Error(w, "reading history: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	imp, err := history.Parse(b, loc)
+	if err != nil {
+		http.Error(w, "parsing history: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	report, err := ImportHistory(r.Context(), srv.store, userID, imp)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInsufficientStorage)
+		srv.logger.Error("importing history",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"error", err.Error(),
+		)
+		return
+	}
+	srv.logger.Info("imported history",
+		"remote", r.RemoteAddr,
+		"user_id", userID,
+		"format", report.Format,
+		"sessions", len(report.Sessions),
+		"imported", report.Imported,
+		"duplicates", report.Duplicates,
+	)
+	srv.sendJSON(w, report)
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleImport(t *testing.T) {
+	t.Parallel()
+
+	srv, st := newTestServer(t)
+	token, err := srv.auth.issue("test-user")
+	require.NoError(t, err)
+	b, err := os.ReadFile(filepath.Join("history", "testdata", "traktor.nml"))
+	require.NoError(t, err)
+
+	// curl --data-binary sends a form content type
+	r := httptest.NewRequest("POST", "/_import/test-user?tz=America/New_York", bytes.NewReader(b))
+	r.Header.Set(headerContentType, "application/x-www-form-urlencoded")
+	r.Header.Set(headerToken, token.RawToken)
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var report ImportReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	require.Equal(t, 2, report.Imported)
+	require.Len(t, report.Sessions, 1)
+	started := time.UnixMilli(report.Sessions[0].Started).UTC()
+	require.Equal(t, "2024-03-02 02:00:05", started.Format(time.DateTime), "in the tz parameter's zone")
+
+	sessions, err := st.SessionsList(t.Context(), "test-user")
+	require.NoError(t, err)
+	require.Equal(t, []int64{report.Sessions[0].Started}, sessions)
+}
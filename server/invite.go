@@ -0,0 +1,77 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/autonomouskoi/trackstar-live/server/store"
+)
+
+// NewInviteCode generates a random invite code
+func NewInviteCode() (string, error) {
+	b := make([]byte, 15)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(b), nil
+}
+
+// handleRedeem exchanges an invite code for a token. The token is returned as
+// base64-encoded protobuf, ready to be pasted into the plugin.
+func (srv *Server) handleRedeem(w http.ResponseWriter, r *http.Request) {
+	code := r.PostFormValue("code")
+	if code == "" {
+		http.Error(w, "required param: code", http.StatusBadRequest)
+		return
+	}
+	userID, err := srv.store.InviteRedeem(r.Context(), code, time.Now().UnixMilli())
+	if errors.Is(err, store.ErrNotFound) {
+		defaultHTTPError(w, http.StatusForbidden)
+		srv.logger.Warn("redeeming invalid invite",
+			"remote", r.RemoteAddr,
+		)
+		return
+	}
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("redeeming invite",
+			"remote", r.RemoteAddr,
+			"error", err.Error(),
+		)
+		return
+	}
+	t, err := srv.auth.issue(userID)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("issuing token for invite",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"error", err.Error(),
+		)
+		return
+	}
+	b, err := proto.Marshal(t)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("marshalling", "type", "Token", "error", err.Error())
+		return
+	}
+	srv.logger.Info("redeemed invite",
+		"user_id", userID,
+		"remote", r.RemoteAddr,
+		"issued_at", t.IssuedAt,
+		"expires", t.ExpiresAt,
+	)
+	encoded := base64.StdEncoding.EncodeToString(b)
+	w.Header().Set(headerContentType, "text/plain")
+	w.Header().Set(headerContentLength, strconv.Itoa(len(encoded)))
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(encoded))
+}
@@ -1,8 +1,26 @@
 package server
 
-import "net/http"
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
 
 func (srv *Server) handleIssue(w http.ResponseWriter, r *http.Request) {
+	ip := srv.remoteIP(r)
+	now := time.Now()
+	if until := srv.issueLockout.lockedUntil(ip, now); !until.IsZero() {
+		retryAfter := int(math.Ceil(until.Sub(now).Seconds()))
+		w.Header().Set(headerRetryAfter, strconv.Itoa(retryAfter))
+		defaultHTTPError(w, http.StatusTooManyRequests)
+		srv.securityEvent("issue_locked_out",
+			"remote", r.RemoteAddr,
+			"ip", ip,
+			"locked_until", until.UnixMilli(),
+		)
+		return
+	}
 	auth := r.Header.Get(headerToken)
 	if auth == "" {
 		http.Error(w, "required header: "+headerToken, http.StatusBadRequest)
@@ -16,13 +34,24 @@ func (srv *Server) handleIssue(w http.ResponseWriter, r *http.Request) {
 	t, err := srv.auth.mintToken(userID, auth)
 	if err != nil {
 		defaultHTTPError(w, http.StatusForbidden)
-		srv.logger.Warn("minting token",
+		failures, locked := srv.issueLockout.failure(ip, now)
+		srv.securityEvent("issue_auth_failure",
 			"error", err.Error(),
 			"remote", r.RemoteAddr,
+			"ip", ip,
 			"user_id", userID,
+			"failures", failures,
 		)
+		if locked {
+			srv.securityEvent("issue_lockout",
+				"remote", r.RemoteAddr,
+				"ip", ip,
+				"failures", failures,
+			)
+		}
 		return
 	}
+	srv.issueLockout.success(ip)
 	srv.logger.Info("issued token",
 		"user_id", userID,
 		"remote", r.RemoteAddr,
@@ -31,3 +60,58 @@ func (srv *Server) handleIssue(w http.ResponseWriter, r *http.Request) {
 	)
 	srv.sendProto(w, t)
 }
+
+// securityEvent logs an event operators may want to alert on. These are all
+// logged with a security_event attribute naming the event.
+func (srv *Server) securityEvent(event string, args ...any) {
+	srv.logger.Warn("security event", append([]any{"security_event", event}, args...)...)
+}
+
+// handleRefresh exchanges a valid token for a new one with a fresh lifetime
+func (srv *Server) handleRefresh(w http.ResponseWriter, r *http.Request) {
+	userID, err := srv.authenticate(r)
+	if err != nil {
+		defaultHTTPError(w, http.StatusForbidden)
+		srv.logger.Warn("bad token for refresh",
+			"remote", r.RemoteAddr,
+			"error", err.Error(),
+		)
+		return
+	}
+	t, err := srv.auth.issue(userID)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("refreshing token",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"error", err.Error(),
+		)
+		return
+	}
+	srv.logger.Info("refreshed token",
+		"user_id", userID,
+		"remote", r.RemoteAddr,
+		"issued_at", t.IssuedAt,
+		"expires", t.ExpiresAt,
+	)
+	srv.sendProto(w, t)
+}
+
+// handlePing lets a client check that its token is accepted without doing
+// anything else
+func (srv *Server) handlePing(w http.ResponseWriter, r *http.Request) {
+	userID, err := srv.authenticate(r)
+	if err != nil {
+		defaultHTTPError(w, http.StatusForbidden)
+		srv.logger.Warn("bad token for ping",
+			"remote", r.RemoteAddr,
+			"error", err.Error(),
+		)
+		return
+	}
+	srv.logger.Debug("ping",
+		"remote", r.RemoteAddr,
+		"user_id", userID,
+	)
+	w.WriteHeader(http.StatusOK)
+}
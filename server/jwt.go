@@ -1,8 +1,8 @@
 package server
 
 import (
-	"bytes"
 	"crypto/sha256"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"time"
@@ -11,13 +11,14 @@ import (
 )
 
 const (
-	tokenLifetime = time.Hour * 24 * 365 * 10
+	defaultTokenLifetime = time.Hour * 24 * 365 * 10
 )
 
 type jwtAuth struct {
 	issuer   string
 	audience string
 	key      []byte
+	lifetime time.Duration
 }
 
 func processKey(input string) []byte {
@@ -30,6 +31,7 @@ func newJWTAuth(cfg *ServerConfig) *jwtAuth {
 		issuer:   cfg.MyURL,
 		audience: cfg.MyURL,
 		key:      processKey(cfg.MyKeyInput),
+		lifetime: cfg.TokenLifetime,
 	}
 }
 
@@ -39,11 +41,16 @@ func (ja *jwtAuth) keyFunc(_ *jwt.Token) (any, error) {
 
 func (ja *jwtAuth) mintToken(userID, keyInput string) (*Token, error) {
 	gotKey := processKey(keyInput)
-	if !bytes.Equal(gotKey, ja.key) {
+	if subtle.ConstantTimeCompare(gotKey, ja.key) != 1 {
 		return nil, errors.New("invalid key")
 	}
+	return ja.issue(userID)
+}
+
+// issue creates a token for userID without checking any credentials
+func (ja *jwtAuth) issue(userID string) (*Token, error) {
 	now := time.Now()
-	expires := now.Add(tokenLifetime)
+	expires := now.Add(ja.lifetime)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwt.RegisteredClaims{
 		Issuer:    ja.issuer,
 		Subject:   userID,
@@ -67,21 +74,28 @@ func (ja *jwtAuth) mintToken(userID, keyInput string) (*Token, error) {
 }
 
 func (ja *jwtAuth) parse(tokenString string) (string, error) {
-	t, err := jwt.Parse(tokenString, ja.keyFunc,
+	claims, err := ja.claims(tokenString)
+	if err != nil {
+		return "", err
+	}
+	return claims.Subject, nil
+}
+
+// claims verifies tokenString and returns its claims. This doesn't check
+// whether the token has been revoked.
+func (ja *jwtAuth) claims(tokenString string) (*jwt.RegisteredClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, ja.keyFunc,
 		jwt.WithAudience(ja.audience),
 		jwt.WithExpirationRequired(),
 		jwt.WithIssuer(ja.issuer),
 		jwt.WithValidMethods([]string{"HS256"}),
 	)
 	if err != nil {
-		return "", err
-	}
-	sub, err := t.Claims.GetSubject()
-	if err != nil {
-		return "", fmt.Errorf("getting subject: %w", err)
+		return nil, err
 	}
-	if sub == "" {
-		return "", errors.New("no subject")
+	if claims.Subject == "" {
+		return nil, errors.New("no subject")
 	}
-	return sub, nil
+	return claims, nil
 }
@@ -72,16 +72,25 @@ func (BusTopic) EnumDescriptor() ([]byte, []int) {
 type MessageTypeEvent int32
 
 const (
-	MessageTypeEvent_TRACK_SEND_EVENT MessageTypeEvent = 0
+	MessageTypeEvent_TRACK_SEND_EVENT         MessageTypeEvent = 0
+	MessageTypeEvent_DESTINATION_HEALTH_EVENT MessageTypeEvent = 1
+	MessageTypeEvent_SESSION_EVENT            MessageTypeEvent = 2
+	MessageTypeEvent_QUEUE_EVENT              MessageTypeEvent = 3
 )
 
 // Enum value maps for MessageTypeEvent.
 var (
 	MessageTypeEvent_name = map[int32]string{
 		0: "TRACK_SEND_EVENT",
+		1: "DESTINATION_HEALTH_EVENT",
+		2: "SESSION_EVENT",
+		3: "QUEUE_EVENT",
 	}
 	MessageTypeEvent_value = map[string]int32{
-		"TRACK_SEND_EVENT": 0,
+		"TRACK_SEND_EVENT":         0,
+		"DESTINATION_HEALTH_EVENT": 1,
+		"SESSION_EVENT":            2,
+		"QUEUE_EVENT":              3,
 	}
 )
 
@@ -112,11 +121,65 @@ func (MessageTypeEvent) EnumDescriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{1}
 }
 
+type RuleAction int32
+
+const (
+	RuleAction_RULE_ACTION_SKIP    RuleAction = 0
+	RuleAction_RULE_ACTION_REWRITE RuleAction = 1
+)
+
+// Enum value maps for RuleAction.
+var (
+	RuleAction_name = map[int32]string{
+		0: "RULE_ACTION_SKIP",
+		1: "RULE_ACTION_REWRITE",
+	}
+	RuleAction_value = map[string]int32{
+		"RULE_ACTION_SKIP":    0,
+		"RULE_ACTION_REWRITE": 1,
+	}
+)
+
+func (x RuleAction) Enum() *RuleAction {
+	p := new(RuleAction)
+	*p = x
+	return p
+}
+
+func (x RuleAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_live_proto_enumTypes[2].Descriptor()
+}
+
+func (RuleAction) Type() protoreflect.EnumType {
+	return &file_live_proto_enumTypes[2]
+}
+
+func (x RuleAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleAction.Descriptor instead.
+func (RuleAction) EnumDescriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{2}
+}
+
 type MessageTypeRequest int32
 
 const (
-	MessageTypeRequest_CONFIG_GET_REQ  MessageTypeRequest = 0
-	MessageTypeRequest_CONFIG_GET_RESP MessageTypeRequest = 1
+	MessageTypeRequest_CONFIG_GET_REQ   MessageTypeRequest = 0
+	MessageTypeRequest_CONFIG_GET_RESP  MessageTypeRequest = 1
+	MessageTypeRequest_QUEUE_GET_REQ    MessageTypeRequest = 2
+	MessageTypeRequest_QUEUE_GET_RESP   MessageTypeRequest = 3
+	MessageTypeRequest_STATUS_GET_REQ   MessageTypeRequest = 4
+	MessageTypeRequest_STATUS_GET_RESP  MessageTypeRequest = 5
+	MessageTypeRequest_SESSION_GET_REQ  MessageTypeRequest = 6
+	MessageTypeRequest_SESSION_GET_RESP MessageTypeRequest = 7
+	MessageTypeRequest_PENDING_GET_REQ  MessageTypeRequest = 8
+	MessageTypeRequest_PENDING_GET_RESP MessageTypeRequest = 9
 )
 
 // Enum value maps for MessageTypeRequest.
@@ -124,10 +187,26 @@ var (
 	MessageTypeRequest_name = map[int32]string{
 		0: "CONFIG_GET_REQ",
 		1: "CONFIG_GET_RESP",
+		2: "QUEUE_GET_REQ",
+		3: "QUEUE_GET_RESP",
+		4: "STATUS_GET_REQ",
+		5: "STATUS_GET_RESP",
+		6: "SESSION_GET_REQ",
+		7: "SESSION_GET_RESP",
+		8: "PENDING_GET_REQ",
+		9: "PENDING_GET_RESP",
 	}
 	MessageTypeRequest_value = map[string]int32{
-		"CONFIG_GET_REQ":  0,
-		"CONFIG_GET_RESP": 1,
+		"CONFIG_GET_REQ":   0,
+		"CONFIG_GET_RESP":  1,
+		"QUEUE_GET_REQ":    2,
+		"QUEUE_GET_RESP":   3,
+		"STATUS_GET_REQ":   4,
+		"STATUS_GET_RESP":  5,
+		"SESSION_GET_REQ":  6,
+		"SESSION_GET_RESP": 7,
+		"PENDING_GET_REQ":  8,
+		"PENDING_GET_RESP": 9,
 	}
 )
 
@@ -142,11 +221,11 @@ func (x MessageTypeRequest) String() string {
 }
 
 func (MessageTypeRequest) Descriptor() protoreflect.EnumDescriptor {
-	return file_live_proto_enumTypes[2].Descriptor()
+	return file_live_proto_enumTypes[3].Descriptor()
 }
 
 func (MessageTypeRequest) Type() protoreflect.EnumType {
-	return &file_live_proto_enumTypes[2]
+	return &file_live_proto_enumTypes[3]
 }
 
 func (x MessageTypeRequest) Number() protoreflect.EnumNumber {
@@ -155,31 +234,73 @@ func (x MessageTypeRequest) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageTypeRequest.Descriptor instead.
 func (MessageTypeRequest) EnumDescriptor() ([]byte, []int) {
-	return file_live_proto_rawDescGZIP(), []int{2}
+	return file_live_proto_rawDescGZIP(), []int{3}
 }
 
 type MessageTypeCommand int32
 
 const (
-	MessageTypeCommand_CONFIG_SET_REQ  MessageTypeCommand = 0
-	MessageTypeCommand_CONFIG_SET_RESP MessageTypeCommand = 1
-	MessageTypeCommand_TOKEN_SET_REQ   MessageTypeCommand = 2
-	MessageTypeCommand_TOKEN_SET_RESP  MessageTypeCommand = 3
+	MessageTypeCommand_CONFIG_SET_REQ       MessageTypeCommand = 0
+	MessageTypeCommand_CONFIG_SET_RESP      MessageTypeCommand = 1
+	MessageTypeCommand_TOKEN_SET_REQ        MessageTypeCommand = 2
+	MessageTypeCommand_TOKEN_SET_RESP       MessageTypeCommand = 3
+	MessageTypeCommand_TEST_CONNECTION_REQ  MessageTypeCommand = 4
+	MessageTypeCommand_TEST_CONNECTION_RESP MessageTypeCommand = 5
+	MessageTypeCommand_SESSION_START_REQ    MessageTypeCommand = 6
+	MessageTypeCommand_SESSION_START_RESP   MessageTypeCommand = 7
+	MessageTypeCommand_SESSION_END_REQ      MessageTypeCommand = 8
+	MessageTypeCommand_SESSION_END_RESP     MessageTypeCommand = 9
+	MessageTypeCommand_SESSION_RESUME_REQ   MessageTypeCommand = 10
+	MessageTypeCommand_SESSION_RESUME_RESP  MessageTypeCommand = 11
+	MessageTypeCommand_HIDE_NEXT_REQ        MessageTypeCommand = 12
+	MessageTypeCommand_HIDE_NEXT_RESP       MessageTypeCommand = 13
+	MessageTypeCommand_MANUAL_TRACK_REQ     MessageTypeCommand = 14
+	MessageTypeCommand_MANUAL_TRACK_RESP    MessageTypeCommand = 15
+	MessageTypeCommand_RECONCILE_REQ        MessageTypeCommand = 16
+	MessageTypeCommand_RECONCILE_RESP       MessageTypeCommand = 17
 )
 
 // Enum value maps for MessageTypeCommand.
 var (
 	MessageTypeCommand_name = map[int32]string{
-		0: "CONFIG_SET_REQ",
-		1: "CONFIG_SET_RESP",
-		2: "TOKEN_SET_REQ",
-		3: "TOKEN_SET_RESP",
+		0:  "CONFIG_SET_REQ",
+		1:  "CONFIG_SET_RESP",
+		2:  "TOKEN_SET_REQ",
+		3:  "TOKEN_SET_RESP",
+		4:  "TEST_CONNECTION_REQ",
+		5:  "TEST_CONNECTION_RESP",
+		6:  "SESSION_START_REQ",
+		7:  "SESSION_START_RESP",
+		8:  "SESSION_END_REQ",
+		9:  "SESSION_END_RESP",
+		10: "SESSION_RESUME_REQ",
+		11: "SESSION_RESUME_RESP",
+		12: "HIDE_NEXT_REQ",
+		13: "HIDE_NEXT_RESP",
+		14: "MANUAL_TRACK_REQ",
+		15: "MANUAL_TRACK_RESP",
+		16: "RECONCILE_REQ",
+		17: "RECONCILE_RESP",
 	}
 	MessageTypeCommand_value = map[string]int32{
-		"CONFIG_SET_REQ":  0,
-		"CONFIG_SET_RESP": 1,
-		"TOKEN_SET_REQ":   2,
-		"TOKEN_SET_RESP":  3,
+		"CONFIG_SET_REQ":       0,
+		"CONFIG_SET_RESP":      1,
+		"TOKEN_SET_REQ":        2,
+		"TOKEN_SET_RESP":       3,
+		"TEST_CONNECTION_REQ":  4,
+		"TEST_CONNECTION_RESP": 5,
+		"SESSION_START_REQ":    6,
+		"SESSION_START_RESP":   7,
+		"SESSION_END_REQ":      8,
+		"SESSION_END_RESP":     9,
+		"SESSION_RESUME_REQ":   10,
+		"SESSION_RESUME_RESP":  11,
+		"HIDE_NEXT_REQ":        12,
+		"HIDE_NEXT_RESP":       13,
+		"MANUAL_TRACK_REQ":     14,
+		"MANUAL_TRACK_RESP":    15,
+		"RECONCILE_REQ":        16,
+		"RECONCILE_RESP":       17,
 	}
 )
 
@@ -194,11 +315,11 @@ func (x MessageTypeCommand) String() string {
 }
 
 func (MessageTypeCommand) Descriptor() protoreflect.EnumDescriptor {
-	return file_live_proto_enumTypes[3].Descriptor()
+	return file_live_proto_enumTypes[4].Descriptor()
 }
 
 func (MessageTypeCommand) Type() protoreflect.EnumType {
-	return &file_live_proto_enumTypes[3]
+	return &file_live_proto_enumTypes[4]
 }
 
 func (x MessageTypeCommand) Number() protoreflect.EnumNumber {
@@ -207,7 +328,70 @@ func (x MessageTypeCommand) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MessageTypeCommand.Descriptor instead.
 func (MessageTypeCommand) EnumDescriptor() ([]byte, []int) {
-	return file_live_proto_rawDescGZIP(), []int{3}
+	return file_live_proto_rawDescGZIP(), []int{4}
+}
+
+// TokenErrorCode is set in the bus error replying to a TokenSetRequest with a
+// token that can't be used
+type TokenErrorCode int32
+
+const (
+	TokenErrorCode_TOKEN_ERROR_UNSPECIFIED   TokenErrorCode = 0
+	TokenErrorCode_TOKEN_ERROR_INVALID       TokenErrorCode = 1
+	TokenErrorCode_TOKEN_ERROR_EXPIRED       TokenErrorCode = 2
+	TokenErrorCode_TOKEN_ERROR_INSECURE      TokenErrorCode = 3
+	TokenErrorCode_TOKEN_ERROR_UNREACHABLE   TokenErrorCode = 4
+	TokenErrorCode_TOKEN_ERROR_REJECTED      TokenErrorCode = 5
+	TokenErrorCode_TOKEN_ERROR_WRONG_SUBJECT TokenErrorCode = 6
+)
+
+// Enum value maps for TokenErrorCode.
+var (
+	TokenErrorCode_name = map[int32]string{
+		0: "TOKEN_ERROR_UNSPECIFIED",
+		1: "TOKEN_ERROR_INVALID",
+		2: "TOKEN_ERROR_EXPIRED",
+		3: "TOKEN_ERROR_INSECURE",
+		4: "TOKEN_ERROR_UNREACHABLE",
+		5: "TOKEN_ERROR_REJECTED",
+		6: "TOKEN_ERROR_WRONG_SUBJECT",
+	}
+	TokenErrorCode_value = map[string]int32{
+		"TOKEN_ERROR_UNSPECIFIED":   0,
+		"TOKEN_ERROR_INVALID":       1,
+		"TOKEN_ERROR_EXPIRED":       2,
+		"TOKEN_ERROR_INSECURE":      3,
+		"TOKEN_ERROR_UNREACHABLE":   4,
+		"TOKEN_ERROR_REJECTED":      5,
+		"TOKEN_ERROR_WRONG_SUBJECT": 6,
+	}
+)
+
+func (x TokenErrorCode) Enum() *TokenErrorCode {
+	p := new(TokenErrorCode)
+	*p = x
+	return p
+}
+
+func (x TokenErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_live_proto_enumTypes[5].Descriptor()
+}
+
+func (TokenErrorCode) Type() protoreflect.EnumType {
+	return &file_live_proto_enumTypes[5]
+}
+
+func (x TokenErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenErrorCode.Descriptor instead.
+func (TokenErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{5}
 }
 
 type Token struct {
@@ -297,17 +481,21 @@ func (x *Token) GetExpiresAt() int64 {
 	return 0
 }
 
-type TokenConfig struct {
+// WhoamiResponse describes the token a request to the server was made with
+type WhoamiResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token   *Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Subject   string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Scopes    []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	IssuedAt  int64    `protobuf:"varint,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt int64    `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Revoked   bool     `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
 }
 
-func (x *TokenConfig) Reset() {
-	*x = TokenConfig{}
+func (x *WhoamiResponse) Reset() {
+	*x = WhoamiResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -315,13 +503,13 @@ func (x *TokenConfig) Reset() {
 	}
 }
 
-func (x *TokenConfig) String() string {
+func (x *WhoamiResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenConfig) ProtoMessage() {}
+func (*WhoamiResponse) ProtoMessage() {}
 
-func (x *TokenConfig) ProtoReflect() protoreflect.Message {
+func (x *WhoamiResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -333,35 +521,67 @@ func (x *TokenConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenConfig.ProtoReflect.Descriptor instead.
-func (*TokenConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use WhoamiResponse.ProtoReflect.Descriptor instead.
+func (*WhoamiResponse) Descriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *TokenConfig) GetToken() *Token {
+func (x *WhoamiResponse) GetSubject() string {
 	if x != nil {
-		return x.Token
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *WhoamiResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
 	}
 	return nil
 }
 
-func (x *TokenConfig) GetEnabled() bool {
+func (x *WhoamiResponse) GetIssuedAt() int64 {
 	if x != nil {
-		return x.Enabled
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *WhoamiResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *WhoamiResponse) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
 	}
 	return false
 }
 
-type Config struct {
+// SessionState tracks the session track updates are sent to. Session IDs are
+// the time the session started, in milliseconds. An empty current session, or
+// one idle for longer than the configured session_idle_minutes, means the next
+// track starts a new one.
+type SessionState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tokens map[string]*TokenConfig `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Current  string `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Previous string `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	// the index of the last update published in each session
+	LastIndex         int32 `protobuf:"varint,3,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	PreviousLastIndex int32 `protobuf:"varint,4,opt,name=previous_last_index,json=previousLastIndex,proto3" json:"previous_last_index,omitempty"`
+	// last_track_at is when the current session last published a track, or
+	// was started or resumed, in milliseconds
+	LastTrackAt int64 `protobuf:"varint,5,opt,name=last_track_at,json=lastTrackAt,proto3" json:"last_track_at,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *SessionState) Reset() {
+	*x = SessionState{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,13 +589,13 @@ func (x *Config) Reset() {
 	}
 }
 
-func (x *Config) String() string {
+func (x *SessionState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*SessionState) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
+func (x *SessionState) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -387,26 +607,57 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionState.ProtoReflect.Descriptor instead.
+func (*SessionState) Descriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Config) GetTokens() map[string]*TokenConfig {
+func (x *SessionState) GetCurrent() string {
 	if x != nil {
-		return x.Tokens
+		return x.Current
 	}
-	return nil
+	return ""
 }
 
-type GetConfigRequest struct {
+func (x *SessionState) GetPrevious() string {
+	if x != nil {
+		return x.Previous
+	}
+	return ""
+}
+
+func (x *SessionState) GetLastIndex() int32 {
+	if x != nil {
+		return x.LastIndex
+	}
+	return 0
+}
+
+func (x *SessionState) GetPreviousLastIndex() int32 {
+	if x != nil {
+		return x.PreviousLastIndex
+	}
+	return 0
+}
+
+func (x *SessionState) GetLastTrackAt() int64 {
+	if x != nil {
+		return x.LastTrackAt
+	}
+	return 0
+}
+
+// SessionHistory holds the marshalled updates published in a session
+type SessionHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TrackUpdates [][]byte `protobuf:"bytes,1,rep,name=track_updates,json=trackUpdates,proto3" json:"track_updates,omitempty"`
 }
 
-func (x *GetConfigRequest) Reset() {
-	*x = GetConfigRequest{}
+func (x *SessionHistory) Reset() {
+	*x = SessionHistory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,13 +665,13 @@ func (x *GetConfigRequest) Reset() {
 	}
 }
 
-func (x *GetConfigRequest) String() string {
+func (x *SessionHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigRequest) ProtoMessage() {}
+func (*SessionHistory) ProtoMessage() {}
 
-func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *SessionHistory) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,21 +683,29 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SessionHistory.ProtoReflect.Descriptor instead.
+func (*SessionHistory) Descriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{3}
 }
 
-type GetConfigResponse struct {
+func (x *SessionHistory) GetTrackUpdates() [][]byte {
+	if x != nil {
+		return x.TrackUpdates
+	}
+	return nil
+}
+
+// History is kept for the current and previous sessions, keyed by session
+type History struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Sessions map[string]*SessionHistory `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
+func (x *History) Reset() {
+	*x = History{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,13 +713,13 @@ func (x *GetConfigResponse) Reset() {
 	}
 }
 
-func (x *GetConfigResponse) String() string {
+func (x *History) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponse) ProtoMessage() {}
+func (*History) ProtoMessage() {}
 
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *History) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,28 +731,34 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use History.ProtoReflect.Descriptor instead.
+func (*History) Descriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetConfigResponse) GetConfig() *Config {
+func (x *History) GetSessions() map[string]*SessionHistory {
 	if x != nil {
-		return x.Config
+		return x.Sessions
 	}
 	return nil
 }
 
-type SetConfigRequest struct {
+// IndexedTrackUpdate is wire compatible with trackstar's TrackUpdate, exposing
+// the index field the plugin assigns. The other fields are preserved as
+// unknown fields. index is `int32 index = 5` in TrackUpdate in trackstar's
+// trackstar.proto, which the server reads as trackstar.TrackUpdate.Index. The
+// trackstar-tinygo package the plugin builds with predates it, hence this
+// message; TestIndexFieldNumber in the server fails if the numbers diverge.
+type IndexedTrackUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Index int32 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *SetConfigRequest) Reset() {
-	*x = SetConfigRequest{}
+func (x *IndexedTrackUpdate) Reset() {
+	*x = IndexedTrackUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,13 +766,13 @@ func (x *SetConfigRequest) Reset() {
 	}
 }
 
-func (x *SetConfigRequest) String() string {
+func (x *IndexedTrackUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetConfigRequest) ProtoMessage() {}
+func (*IndexedTrackUpdate) ProtoMessage() {}
 
-func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *IndexedTrackUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -519,28 +784,36 @@ func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
-func (*SetConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IndexedTrackUpdate.ProtoReflect.Descriptor instead.
+func (*IndexedTrackUpdate) Descriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SetConfigRequest) GetConfig() *Config {
+func (x *IndexedTrackUpdate) GetIndex() int32 {
 	if x != nil {
-		return x.Config
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-type SetConfigResponse struct {
+type TokenConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Token   *Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// delay_ms holds updates for this long before publishing them
+	DelayMs int64 `protobuf:"varint,3,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	// delay_until_next holds each update until the next one starts, instead
+	// of delay_ms
+	DelayUntilNext bool `protobuf:"varint,4,opt,name=delay_until_next,json=delayUntilNext,proto3" json:"delay_until_next,omitempty"`
+	// label is the name the DJ gives the token
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *SetConfigResponse) Reset() {
-	*x = SetConfigResponse{}
+func (x *TokenConfig) Reset() {
+	*x = TokenConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +821,13 @@ func (x *SetConfigResponse) Reset() {
 	}
 }
 
-func (x *SetConfigResponse) String() string {
+func (x *TokenConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetConfigResponse) ProtoMessage() {}
+func (*TokenConfig) ProtoMessage() {}
 
-func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *TokenConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,30 +839,65 @@ func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetConfigResponse.ProtoReflect.Descriptor instead.
-func (*SetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenConfig.ProtoReflect.Descriptor instead.
+func (*TokenConfig) Descriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SetConfigResponse) GetConfig() *Config {
+func (x *TokenConfig) GetToken() *Token {
 	if x != nil {
-		return x.Config
+		return x.Token
 	}
 	return nil
 }
 
-type TokenSetRequest struct {
+func (x *TokenConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TokenConfig) GetDelayMs() int64 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+func (x *TokenConfig) GetDelayUntilNext() bool {
+	if x != nil {
+		return x.DelayUntilNext
+	}
+	return false
+}
+
+func (x *TokenConfig) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// Rule matches track updates by deck and by artist and title regular
+// expressions. Empty criteria match everything, but a rule must have at least
+// one. Matching updates are either skipped or have their artist and title
+// replaced with the non-empty rewrite values.
+type Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label    string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	RawToken string `protobuf:"bytes,2,opt,name=raw_token,json=rawToken,proto3" json:"raw_token,omitempty"`
-	Enabled  *bool  `protobuf:"varint,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	DeckId        string     `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	ArtistPattern string     `protobuf:"bytes,2,opt,name=artist_pattern,json=artistPattern,proto3" json:"artist_pattern,omitempty"`
+	TitlePattern  string     `protobuf:"bytes,3,opt,name=title_pattern,json=titlePattern,proto3" json:"title_pattern,omitempty"`
+	Action        RuleAction `protobuf:"varint,4,opt,name=action,proto3,enum=live.RuleAction" json:"action,omitempty"`
+	RewriteArtist string     `protobuf:"bytes,5,opt,name=rewrite_artist,json=rewriteArtist,proto3" json:"rewrite_artist,omitempty"`
+	RewriteTitle  string     `protobuf:"bytes,6,opt,name=rewrite_title,json=rewriteTitle,proto3" json:"rewrite_title,omitempty"`
 }
 
-func (x *TokenSetRequest) Reset() {
-	*x = TokenSetRequest{}
+func (x *Rule) Reset() {
+	*x = Rule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,13 +905,13 @@ func (x *TokenSetRequest) Reset() {
 	}
 }
 
-func (x *TokenSetRequest) String() string {
+func (x *Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenSetRequest) ProtoMessage() {}
+func (*Rule) ProtoMessage() {}
 
-func (x *TokenSetRequest) ProtoReflect() protoreflect.Message {
+func (x *Rule) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,40 +923,74 @@ func (x *TokenSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenSetRequest.ProtoReflect.Descriptor instead.
-func (*TokenSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
 	return file_live_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TokenSetRequest) GetLabel() string {
+func (x *Rule) GetDeckId() string {
 	if x != nil {
-		return x.Label
+		return x.DeckId
 	}
 	return ""
 }
 
-func (x *TokenSetRequest) GetRawToken() string {
+func (x *Rule) GetArtistPattern() string {
 	if x != nil {
-		return x.RawToken
+		return x.ArtistPattern
 	}
 	return ""
 }
 
-func (x *TokenSetRequest) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
+func (x *Rule) GetTitlePattern() string {
+	if x != nil {
+		return x.TitlePattern
 	}
-	return false
+	return ""
 }
 
-type TokenSetResponse struct {
+func (x *Rule) GetAction() RuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return RuleAction_RULE_ACTION_SKIP
+}
+
+func (x *Rule) GetRewriteArtist() string {
+	if x != nil {
+		return x.RewriteArtist
+	}
+	return ""
+}
+
+func (x *Rule) GetRewriteTitle() string {
+	if x != nil {
+		return x.RewriteTitle
+	}
+	return ""
+}
+
+type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// tokens are keyed by an ID generated when the token is added
+	Tokens map[string]*TokenConfig `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// rules are applied in order, a skip rule ends processing
+	Rules []*Rule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// deck_names maps deck IDs to names to publish instead
+	DeckNames map[string]string `protobuf:"bytes,3,rep,name=deck_names,json=deckNames,proto3" json:"deck_names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// hide_next skips the next track update, then clears itself
+	HideNext bool `protobuf:"varint,4,opt,name=hide_next,json=hideNext,proto3" json:"hide_next,omitempty"`
+	// session_idle_minutes is how long the current session may go without a
+	// track, e.g. across a restart, before the next track starts a new one.
+	// Zero uses the default.
+	SessionIdleMinutes int64 `protobuf:"varint,5,opt,name=session_idle_minutes,json=sessionIdleMinutes,proto3" json:"session_idle_minutes,omitempty"`
 }
 
-func (x *TokenSetResponse) Reset() {
-	*x = TokenSetResponse{}
+func (x *Config) Reset() {
+	*x = Config{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_live_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,155 +998,2606 @@ func (x *TokenSetResponse) Reset() {
 	}
 }
 
-func (x *TokenSetResponse) String() string {
+func (x *Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenSetResponse) ProtoMessage() {}
+func (*Config) ProtoMessage() {}
 
-func (x *TokenSetResponse) ProtoReflect() protoreflect.Message {
+func (x *Config) ProtoReflect() protoreflect.Message {
 	mi := &file_live_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TokenSetResponse.ProtoReflect.Descriptor instead.
-func (*TokenSetResponse) Descriptor() ([]byte, []int) {
-	return file_live_proto_rawDescGZIP(), []int{8}
-}
-
-var File_live_proto protoreflect.FileDescriptor
-
-var file_live_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6c, 0x69,
-	0x76, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x72, 0x61, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x61, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
-	0x88, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76,
-	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x4c, 0x0a, 0x0b,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c,
-	0x69, 0x76, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6f,
-	0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x77, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
-	0x12, 0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2a, 0x5c, 0x0a, 0x08, 0x42, 0x75, 0x73, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12,
-	0x18, 0x0a, 0x14, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x53, 0x54, 0x41, 0x52, 0x5f, 0x4c, 0x49, 0x56,
-	0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41,
-	0x43, 0x4b, 0x53, 0x54, 0x41, 0x52, 0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x53, 0x54,
-	0x41, 0x52, 0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10,
-	0x02, 0x2a, 0x28, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x53,
-	0x45, 0x4e, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x2a, 0x3d, 0x0a, 0x12, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x47, 0x45, 0x54, 0x5f,
-	0x52, 0x45, 0x51, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x01, 0x2a, 0x64, 0x0a, 0x12, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x52,
-	0x45, 0x51, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53,
-	0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b,
-	0x45, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
-	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x03,
-	0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
-	0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x6f, 0x75, 0x73, 0x6b, 0x6f, 0x69, 0x2f, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x74, 0x61, 0x72, 0x2d, 0x6c, 0x69, 0x76, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_live_proto_rawDescOnce sync.Once
-	file_live_proto_rawDescData = file_live_proto_rawDesc
-)
-
-func file_live_proto_rawDescGZIP() []byte {
-	file_live_proto_rawDescOnce.Do(func() {
-		file_live_proto_rawDescData = protoimpl.X.CompressGZIP(file_live_proto_rawDescData)
-	})
-	return file_live_proto_rawDescData
-}
-
-var file_live_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_live_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_live_proto_goTypes = []any{
-	(BusTopic)(0),             // 0: live.BusTopic
-	(MessageTypeEvent)(0),     // 1: live.MessageTypeEvent
-	(MessageTypeRequest)(0),   // 2: live.MessageTypeRequest
-	(MessageTypeCommand)(0),   // 3: live.MessageTypeCommand
-	(*Token)(nil),             // 4: live.Token
-	(*TokenConfig)(nil),       // 5: live.TokenConfig
-	(*Config)(nil),            // 6: live.Config
-	(*GetConfigRequest)(nil),  // 7: live.GetConfigRequest
-	(*GetConfigResponse)(nil), // 8: live.GetConfigResponse
-	(*SetConfigRequest)(nil),  // 9: live.SetConfigRequest
-	(*SetConfigResponse)(nil), // 10: live.SetConfigResponse
-	(*TokenSetRequest)(nil),   // 11: live.TokenSetRequest
-	(*TokenSetResponse)(nil),  // 12: live.TokenSetResponse
-	nil,                       // 13: live.Config.TokensEntry
-}
-var file_live_proto_depIdxs = []int32{
-	4,  // 0: live.TokenConfig.token:type_name -> live.Token
-	13, // 1: live.Config.tokens:type_name -> live.Config.TokensEntry
-	6,  // 2: live.GetConfigResponse.config:type_name -> live.Config
-	6,  // 3: live.SetConfigRequest.config:type_name -> live.Config
-	6,  // 4: live.SetConfigResponse.config:type_name -> live.Config
-	5,  // 5: live.Config.TokensEntry.value:type_name -> live.TokenConfig
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
-}
-
-func init() { file_live_proto_init() }
-func file_live_proto_init() {
-	if File_live_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_live_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Token); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Config) GetTokens() map[string]*TokenConfig {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *Config) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Config) GetDeckNames() map[string]string {
+	if x != nil {
+		return x.DeckNames
+	}
+	return nil
+}
+
+func (x *Config) GetHideNext() bool {
+	if x != nil {
+		return x.HideNext
+	}
+	return false
+}
+
+func (x *Config) GetSessionIdleMinutes() int64 {
+	if x != nil {
+		return x.SessionIdleMinutes
+	}
+	return 0
+}
+
+// QueuedUpdate is a track update waiting to be sent to a server
+type QueuedUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackUpdate []byte `protobuf:"bytes,1,opt,name=track_update,json=trackUpdate,proto3" json:"track_update,omitempty"`
+	Session     string `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// release_at is when the update may be published, zero for immediately
+	ReleaseAt int64 `protobuf:"varint,3,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+	// held_for_next updates are published when the next update arrives
+	HeldForNext bool `protobuf:"varint,4,opt,name=held_for_next,json=heldForNext,proto3" json:"held_for_next,omitempty"`
+}
+
+func (x *QueuedUpdate) Reset() {
+	*x = QueuedUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedUpdate) ProtoMessage() {}
+
+func (x *QueuedUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedUpdate.ProtoReflect.Descriptor instead.
+func (*QueuedUpdate) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueuedUpdate) GetTrackUpdate() []byte {
+	if x != nil {
+		return x.TrackUpdate
+	}
+	return nil
+}
+
+func (x *QueuedUpdate) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *QueuedUpdate) GetReleaseAt() int64 {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return 0
+}
+
+func (x *QueuedUpdate) GetHeldForNext() bool {
+	if x != nil {
+		return x.HeldForNext
+	}
+	return false
+}
+
+// DestinationHealth tracks delivery to the server one token sends to
+type DestinationHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastSuccess         int64  `protobuf:"varint,1,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastError           string `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt         int64  `protobuf:"varint,3,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`
+	ConsecutiveFailures int32  `protobuf:"varint,4,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	TotalSent           int64  `protobuf:"varint,5,opt,name=total_sent,json=totalSent,proto3" json:"total_sent,omitempty"`
+	// total_failed counts updates given up on, either rejected by the
+	// server or dropped from a full outbox. Retries aren't counted.
+	TotalFailed int64 `protobuf:"varint,6,opt,name=total_failed,json=totalFailed,proto3" json:"total_failed,omitempty"`
+}
+
+func (x *DestinationHealth) Reset() {
+	*x = DestinationHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestinationHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestinationHealth) ProtoMessage() {}
+
+func (x *DestinationHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestinationHealth.ProtoReflect.Descriptor instead.
+func (*DestinationHealth) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DestinationHealth) GetLastSuccess() int64 {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return 0
+}
+
+func (x *DestinationHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DestinationHealth) GetLastErrorAt() int64 {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return 0
+}
+
+func (x *DestinationHealth) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *DestinationHealth) GetTotalSent() int64 {
+	if x != nil {
+		return x.TotalSent
+	}
+	return 0
+}
+
+func (x *DestinationHealth) GetTotalFailed() int64 {
+	if x != nil {
+		return x.TotalFailed
+	}
+	return 0
+}
+
+// DestinationHealthEvent is sent after each attempt to deliver a token's
+// updates. Delivery is serialized: the plugin sends one update at a time,
+// tokens taking turns, so a slow destination delays the others by up to its
+// send timeout per turn.
+type DestinationHealthEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId string             `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Health  *DestinationHealth `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *DestinationHealthEvent) Reset() {
+	*x = DestinationHealthEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DestinationHealthEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestinationHealthEvent) ProtoMessage() {}
+
+func (x *DestinationHealthEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestinationHealthEvent.ProtoReflect.Descriptor instead.
+func (*DestinationHealthEvent) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DestinationHealthEvent) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *DestinationHealthEvent) GetHealth() *DestinationHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// Outbox holds the updates waiting to be sent using one token, in order
+type Outbox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updates     []*QueuedUpdate    `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	NextAttempt int64              `protobuf:"varint,3,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"`
+	Health      *DestinationHealth `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *Outbox) Reset() {
+	*x = Outbox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Outbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Outbox) ProtoMessage() {}
+
+func (x *Outbox) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Outbox.ProtoReflect.Descriptor instead.
+func (*Outbox) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Outbox) GetUpdates() []*QueuedUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *Outbox) GetNextAttempt() int64 {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return 0
+}
+
+func (x *Outbox) GetHealth() *DestinationHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// Outboxes are keyed by token ID
+type Outboxes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outboxes map[string]*Outbox `protobuf:"bytes,1,rep,name=outboxes,proto3" json:"outboxes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Outboxes) Reset() {
+	*x = Outboxes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Outboxes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Outboxes) ProtoMessage() {}
+
+func (x *Outboxes) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Outboxes.ProtoReflect.Descriptor instead.
+func (*Outboxes) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Outboxes) GetOutboxes() map[string]*Outbox {
+	if x != nil {
+		return x.Outboxes
+	}
+	return nil
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{14}
+}
+
+type GetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetConfigResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type QueueStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Depth       int32              `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+	NextAttempt int64              `protobuf:"varint,3,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"`
+	Health      *DestinationHealth `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *QueueStatus) Reset() {
+	*x = QueueStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatus) ProtoMessage() {}
+
+func (x *QueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatus.ProtoReflect.Descriptor instead.
+func (*QueueStatus) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueueStatus) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetNextAttempt() int64 {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetHealth() *DestinationHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type GetQueueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQueueRequest) Reset() {
+	*x = GetQueueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueRequest) ProtoMessage() {}
+
+func (x *GetQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{17}
+}
+
+type GetQueueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keyed by token ID
+	Queues map[string]*QueueStatus `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetQueueResponse) Reset() {
+	*x = GetQueueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueResponse) ProtoMessage() {}
+
+func (x *GetQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetQueueResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetQueueResponse) GetQueues() map[string]*QueueStatus {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+// PendingUpdate is an update waiting in a token's outbox
+type PendingUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId string        `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Update  *QueuedUpdate `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *PendingUpdate) Reset() {
+	*x = PendingUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingUpdate) ProtoMessage() {}
+
+func (x *PendingUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingUpdate.ProtoReflect.Descriptor instead.
+func (*PendingUpdate) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PendingUpdate) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *PendingUpdate) GetUpdate() *QueuedUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+type GetPendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPendingRequest) Reset() {
+	*x = GetPendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingRequest) ProtoMessage() {}
+
+func (x *GetPendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{20}
+}
+
+type GetPendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending []*PendingUpdate `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *GetPendingResponse) Reset() {
+	*x = GetPendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingResponse) ProtoMessage() {}
+
+func (x *GetPendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPendingResponse) GetPending() []*PendingUpdate {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+type GetSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{22}
+}
+
+type GetSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *SessionState `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionResponse) ProtoMessage() {}
+
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSessionResponse) GetSession() *SessionState {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type GetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusRequest) ProtoMessage() {}
+
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{24}
+}
+
+type GetStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keyed by token ID
+	Destinations map[string]*DestinationHealth `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetStatusResponse) Reset() {
+	*x = GetStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse) ProtoMessage() {}
+
+func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetStatusResponse) GetDestinations() map[string]*DestinationHealth {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+type SetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetConfigRequest) Reset() {
+	*x = SetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigRequest) ProtoMessage() {}
+
+func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetConfigRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *SetConfigResponse) Reset() {
+	*x = SetConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigResponse) ProtoMessage() {}
+
+func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetConfigResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// TokenSetRequest adds a token if token_id is empty, otherwise it updates the
+// token with that ID. A request with only token_id deletes the token.
+type TokenSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label          string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	RawToken       string `protobuf:"bytes,2,opt,name=raw_token,json=rawToken,proto3" json:"raw_token,omitempty"`
+	Enabled        *bool  `protobuf:"varint,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	DelayMs        *int64 `protobuf:"varint,4,opt,name=delay_ms,json=delayMs,proto3,oneof" json:"delay_ms,omitempty"`
+	DelayUntilNext *bool  `protobuf:"varint,5,opt,name=delay_until_next,json=delayUntilNext,proto3,oneof" json:"delay_until_next,omitempty"`
+	TokenId        string `protobuf:"bytes,6,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// allow_insecure accepts a token for a server that isn't using https
+	AllowInsecure bool `protobuf:"varint,7,opt,name=allow_insecure,json=allowInsecure,proto3" json:"allow_insecure,omitempty"`
+	// skip_verify doesn't check the token with its server
+	SkipVerify bool `protobuf:"varint,8,opt,name=skip_verify,json=skipVerify,proto3" json:"skip_verify,omitempty"`
+}
+
+func (x *TokenSetRequest) Reset() {
+	*x = TokenSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSetRequest) ProtoMessage() {}
+
+func (x *TokenSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenSetRequest.ProtoReflect.Descriptor instead.
+func (*TokenSetRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TokenSetRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TokenSetRequest) GetRawToken() string {
+	if x != nil {
+		return x.RawToken
+	}
+	return ""
+}
+
+func (x *TokenSetRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *TokenSetRequest) GetDelayMs() int64 {
+	if x != nil && x.DelayMs != nil {
+		return *x.DelayMs
+	}
+	return 0
+}
+
+func (x *TokenSetRequest) GetDelayUntilNext() bool {
+	if x != nil && x.DelayUntilNext != nil {
+		return *x.DelayUntilNext
+	}
+	return false
+}
+
+func (x *TokenSetRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *TokenSetRequest) GetAllowInsecure() bool {
+	if x != nil {
+		return x.AllowInsecure
+	}
+	return false
+}
+
+func (x *TokenSetRequest) GetSkipVerify() bool {
+	if x != nil {
+		return x.SkipVerify
+	}
+	return false
+}
+
+type TokenSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *TokenSetResponse) Reset() {
+	*x = TokenSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSetResponse) ProtoMessage() {}
+
+func (x *TokenSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenSetResponse.ProtoReflect.Descriptor instead.
+func (*TokenSetResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TokenSetResponse) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type TestConnectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *TestConnectionRequest) Reset() {
+	*x = TestConnectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionRequest) ProtoMessage() {}
+
+func (x *TestConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionRequest.ProtoReflect.Descriptor instead.
+func (*TestConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TestConnectionRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type TestConnectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok         bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs int64  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+}
+
+func (x *TestConnectionResponse) Reset() {
+	*x = TestConnectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestConnectionResponse) ProtoMessage() {}
+
+func (x *TestConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestConnectionResponse.ProtoReflect.Descriptor instead.
+func (*TestConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TestConnectionResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *TestConnectionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TestConnectionResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type SessionStartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SessionStartRequest) Reset() {
+	*x = SessionStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStartRequest) ProtoMessage() {}
+
+func (x *SessionStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStartRequest.ProtoReflect.Descriptor instead.
+func (*SessionStartRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{32}
+}
+
+type SessionStartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *SessionState `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *SessionStartResponse) Reset() {
+	*x = SessionStartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStartResponse) ProtoMessage() {}
+
+func (x *SessionStartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStartResponse.ProtoReflect.Descriptor instead.
+func (*SessionStartResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SessionStartResponse) GetSession() *SessionState {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type SessionEndRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SessionEndRequest) Reset() {
+	*x = SessionEndRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEndRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEndRequest) ProtoMessage() {}
+
+func (x *SessionEndRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEndRequest.ProtoReflect.Descriptor instead.
+func (*SessionEndRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{34}
+}
+
+type SessionEndResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *SessionState `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *SessionEndResponse) Reset() {
+	*x = SessionEndResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEndResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEndResponse) ProtoMessage() {}
+
+func (x *SessionEndResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEndResponse.ProtoReflect.Descriptor instead.
+func (*SessionEndResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SessionEndResponse) GetSession() *SessionState {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type SessionResumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SessionResumeRequest) Reset() {
+	*x = SessionResumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResumeRequest) ProtoMessage() {}
+
+func (x *SessionResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResumeRequest.ProtoReflect.Descriptor instead.
+func (*SessionResumeRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{36}
+}
+
+type SessionResumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *SessionState `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *SessionResumeResponse) Reset() {
+	*x = SessionResumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionResumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResumeResponse) ProtoMessage() {}
+
+func (x *SessionResumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResumeResponse.ProtoReflect.Descriptor instead.
+func (*SessionResumeResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SessionResumeResponse) GetSession() *SessionState {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type HideNextRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hide bool `protobuf:"varint,1,opt,name=hide,proto3" json:"hide,omitempty"`
+}
+
+func (x *HideNextRequest) Reset() {
+	*x = HideNextRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HideNextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideNextRequest) ProtoMessage() {}
+
+func (x *HideNextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideNextRequest.ProtoReflect.Descriptor instead.
+func (*HideNextRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HideNextRequest) GetHide() bool {
+	if x != nil {
+		return x.Hide
+	}
+	return false
+}
+
+type HideNextResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hide bool `protobuf:"varint,1,opt,name=hide,proto3" json:"hide,omitempty"`
+}
+
+func (x *HideNextResponse) Reset() {
+	*x = HideNextResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HideNextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideNextResponse) ProtoMessage() {}
+
+func (x *HideNextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideNextResponse.ProtoReflect.Descriptor instead.
+func (*HideNextResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HideNextResponse) GetHide() bool {
+	if x != nil {
+		return x.Hide
+	}
+	return false
+}
+
+// ManualTrackRequest publishes a track Trackstar didn't detect
+type ManualTrackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeckId string `protobuf:"bytes,1,opt,name=deck_id,json=deckId,proto3" json:"deck_id,omitempty"`
+	Artist string `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
+	Title  string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *ManualTrackRequest) Reset() {
+	*x = ManualTrackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManualTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManualTrackRequest) ProtoMessage() {}
+
+func (x *ManualTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualTrackRequest.ProtoReflect.Descriptor instead.
+func (*ManualTrackRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ManualTrackRequest) GetDeckId() string {
+	if x != nil {
+		return x.DeckId
+	}
+	return ""
+}
+
+func (x *ManualTrackRequest) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *ManualTrackRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type ManualTrackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ManualTrackResponse) Reset() {
+	*x = ManualTrackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManualTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManualTrackResponse) ProtoMessage() {}
+
+func (x *ManualTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualTrackResponse.ProtoReflect.Descriptor instead.
+func (*ManualTrackResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ManualTrackResponse) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// ReconcileRequest compares the current session's history with what a token's
+// server has, resending what's missing. An empty token_id reconciles every
+// enabled token.
+type ReconcileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *ReconcileRequest) Reset() {
+	*x = ReconcileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileRequest) ProtoMessage() {}
+
+func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReconcileRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type ReconcileResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Missing int32  `protobuf:"varint,1,opt,name=missing,proto3" json:"missing,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ReconcileResult) Reset() {
+	*x = ReconcileResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileResult) ProtoMessage() {}
+
+func (x *ReconcileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileResult.ProtoReflect.Descriptor instead.
+func (*ReconcileResult) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReconcileResult) GetMissing() int32 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+func (x *ReconcileResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReconcileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keyed by token ID
+	Results map[string]*ReconcileResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ReconcileResponse) Reset() {
+	*x = ReconcileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_live_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileResponse) ProtoMessage() {}
+
+func (x *ReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_live_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_live_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReconcileResponse) GetResults() map[string]*ReconcileResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_live_proto protoreflect.FileDescriptor
+
+var file_live_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6c, 0x69,
+	0x76, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x61, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x61, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x57, 0x68, 0x6f, 0x61, 0x6d, 0x69, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0xb7,
+	0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x74, 0x22, 0x35, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x95, 0x01, 0x0a, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x51, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2a, 0x0a, 0x12, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xe1, 0x01, 0x0a, 0x04,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x22,
+	0xf3, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3a,
+	0x0a, 0x0a, 0x64, 0x65, 0x63, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x44, 0x65, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x64, 0x65, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69,
+	0x64, 0x65, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68,
+	0x69, 0x64, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x4c, 0x0a, 0x0b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6b, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x65, 0x6c, 0x64, 0x46,
+	0x6f, 0x72, 0x4e, 0x65, 0x78, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x41, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x90, 0x01,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x12, 0x2c, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0x8f, 0x01, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x12, 0x38, 0x0a,
+	0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x2e,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6f,
+	0x75, 0x74, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x1a, 0x49, 0x0a, 0x0d, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x7d, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x73, 0x1a, 0x4c, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x56, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x12, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xbc, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x58, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x38, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc3, 0x02, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x4e, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x2d, 0x0a, 0x10, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x15, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x5f,
+	0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0x15, 0x0a, 0x13, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x42, 0x0a, 0x12, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a,
+	0x15, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x0f, 0x48, 0x69, 0x64, 0x65, 0x4e, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x69, 0x64, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x48,
+	0x69, 0x64, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68,
+	0x69, 0x64, 0x65, 0x22, 0x5b, 0x0a, 0x12, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x22, 0x2b, 0x0a, 0x13, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2d, 0x0a,
+	0x10, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x0f,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xa6, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x51, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x5c, 0x0a, 0x08, 0x42, 0x75, 0x73, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x53, 0x54, 0x41,
+	0x52, 0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1a,
+	0x0a, 0x16, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x53, 0x54, 0x41, 0x52, 0x5f, 0x4c, 0x49, 0x56, 0x45,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52,
+	0x41, 0x43, 0x4b, 0x53, 0x54, 0x41, 0x52, 0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x41, 0x4e, 0x44, 0x10, 0x02, 0x2a, 0x6a, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52,
+	0x41, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10,
+	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x10, 0x03, 0x2a, 0x3b, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x10, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4b, 0x49, 0x50, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x01, 0x2a,
+	0xe3, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x51,
+	0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x47, 0x45, 0x54, 0x5f,
+	0x52, 0x45, 0x53, 0x50, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x05, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52,
+	0x45, 0x51, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x08, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x10, 0x09, 0x2a, 0x9d, 0x03, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x0e,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x53,
+	0x45, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13,
+	0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x51, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x05, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x5f, 0x52, 0x45, 0x51, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x07, 0x12, 0x13,
+	0x0a, 0x0f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45,
+	0x51, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x4e, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x09, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x10,
+	0x0a, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53,
+	0x55, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x49,
+	0x44, 0x45, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x0c, 0x12, 0x12, 0x0a,
+	0x0e, 0x48, 0x49, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10,
+	0x0d, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43,
+	0x4b, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x0e, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x4e, 0x55, 0x41,
+	0x4c, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x0f, 0x12, 0x11,
+	0x0a, 0x0d, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x10,
+	0x10, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x45, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x10, 0x11, 0x2a, 0xcf, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x18,
+	0x0a, 0x14, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x53, 0x55,
+	0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x06, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x6f, 0x75, 0x73,
+	0x6b, 0x6f, 0x69, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x61, 0x72, 0x2d, 0x6c, 0x69,
+	0x76, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_live_proto_rawDescOnce sync.Once
+	file_live_proto_rawDescData = file_live_proto_rawDesc
+)
+
+func file_live_proto_rawDescGZIP() []byte {
+	file_live_proto_rawDescOnce.Do(func() {
+		file_live_proto_rawDescData = protoimpl.X.CompressGZIP(file_live_proto_rawDescData)
+	})
+	return file_live_proto_rawDescData
+}
+
+var file_live_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_live_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_live_proto_goTypes = []any{
+	(BusTopic)(0),                  // 0: live.BusTopic
+	(MessageTypeEvent)(0),          // 1: live.MessageTypeEvent
+	(RuleAction)(0),                // 2: live.RuleAction
+	(MessageTypeRequest)(0),        // 3: live.MessageTypeRequest
+	(MessageTypeCommand)(0),        // 4: live.MessageTypeCommand
+	(TokenErrorCode)(0),            // 5: live.TokenErrorCode
+	(*Token)(nil),                  // 6: live.Token
+	(*WhoamiResponse)(nil),         // 7: live.WhoamiResponse
+	(*SessionState)(nil),           // 8: live.SessionState
+	(*SessionHistory)(nil),         // 9: live.SessionHistory
+	(*History)(nil),                // 10: live.History
+	(*IndexedTrackUpdate)(nil),     // 11: live.IndexedTrackUpdate
+	(*TokenConfig)(nil),            // 12: live.TokenConfig
+	(*Rule)(nil),                   // 13: live.Rule
+	(*Config)(nil),                 // 14: live.Config
+	(*QueuedUpdate)(nil),           // 15: live.QueuedUpdate
+	(*DestinationHealth)(nil),      // 16: live.DestinationHealth
+	(*DestinationHealthEvent)(nil), // 17: live.DestinationHealthEvent
+	(*Outbox)(nil),                 // 18: live.Outbox
+	(*Outboxes)(nil),               // 19: live.Outboxes
+	(*GetConfigRequest)(nil),       // 20: live.GetConfigRequest
+	(*GetConfigResponse)(nil),      // 21: live.GetConfigResponse
+	(*QueueStatus)(nil),            // 22: live.QueueStatus
+	(*GetQueueRequest)(nil),        // 23: live.GetQueueRequest
+	(*GetQueueResponse)(nil),       // 24: live.GetQueueResponse
+	(*PendingUpdate)(nil),          // 25: live.PendingUpdate
+	(*GetPendingRequest)(nil),      // 26: live.GetPendingRequest
+	(*GetPendingResponse)(nil),     // 27: live.GetPendingResponse
+	(*GetSessionRequest)(nil),      // 28: live.GetSessionRequest
+	(*GetSessionResponse)(nil),     // 29: live.GetSessionResponse
+	(*GetStatusRequest)(nil),       // 30: live.GetStatusRequest
+	(*GetStatusResponse)(nil),      // 31: live.GetStatusResponse
+	(*SetConfigRequest)(nil),       // 32: live.SetConfigRequest
+	(*SetConfigResponse)(nil),      // 33: live.SetConfigResponse
+	(*TokenSetRequest)(nil),        // 34: live.TokenSetRequest
+	(*TokenSetResponse)(nil),       // 35: live.TokenSetResponse
+	(*TestConnectionRequest)(nil),  // 36: live.TestConnectionRequest
+	(*TestConnectionResponse)(nil), // 37: live.TestConnectionResponse
+	(*SessionStartRequest)(nil),    // 38: live.SessionStartRequest
+	(*SessionStartResponse)(nil),   // 39: live.SessionStartResponse
+	(*SessionEndRequest)(nil),      // 40: live.SessionEndRequest
+	(*SessionEndResponse)(nil),     // 41: live.SessionEndResponse
+	(*SessionResumeRequest)(nil),   // 42: live.SessionResumeRequest
+	(*SessionResumeResponse)(nil),  // 43: live.SessionResumeResponse
+	(*HideNextRequest)(nil),        // 44: live.HideNextRequest
+	(*HideNextResponse)(nil),       // 45: live.HideNextResponse
+	(*ManualTrackRequest)(nil),     // 46: live.ManualTrackRequest
+	(*ManualTrackResponse)(nil),    // 47: live.ManualTrackResponse
+	(*ReconcileRequest)(nil),       // 48: live.ReconcileRequest
+	(*ReconcileResult)(nil),        // 49: live.ReconcileResult
+	(*ReconcileResponse)(nil),      // 50: live.ReconcileResponse
+	nil,                            // 51: live.History.SessionsEntry
+	nil,                            // 52: live.Config.TokensEntry
+	nil,                            // 53: live.Config.DeckNamesEntry
+	nil,                            // 54: live.Outboxes.OutboxesEntry
+	nil,                            // 55: live.GetQueueResponse.QueuesEntry
+	nil,                            // 56: live.GetStatusResponse.DestinationsEntry
+	nil,                            // 57: live.ReconcileResponse.ResultsEntry
+}
+var file_live_proto_depIdxs = []int32{
+	51, // 0: live.History.sessions:type_name -> live.History.SessionsEntry
+	6,  // 1: live.TokenConfig.token:type_name -> live.Token
+	2,  // 2: live.Rule.action:type_name -> live.RuleAction
+	52, // 3: live.Config.tokens:type_name -> live.Config.TokensEntry
+	13, // 4: live.Config.rules:type_name -> live.Rule
+	53, // 5: live.Config.deck_names:type_name -> live.Config.DeckNamesEntry
+	16, // 6: live.DestinationHealthEvent.health:type_name -> live.DestinationHealth
+	15, // 7: live.Outbox.updates:type_name -> live.QueuedUpdate
+	16, // 8: live.Outbox.health:type_name -> live.DestinationHealth
+	54, // 9: live.Outboxes.outboxes:type_name -> live.Outboxes.OutboxesEntry
+	14, // 10: live.GetConfigResponse.config:type_name -> live.Config
+	16, // 11: live.QueueStatus.health:type_name -> live.DestinationHealth
+	55, // 12: live.GetQueueResponse.queues:type_name -> live.GetQueueResponse.QueuesEntry
+	15, // 13: live.PendingUpdate.update:type_name -> live.QueuedUpdate
+	25, // 14: live.GetPendingResponse.pending:type_name -> live.PendingUpdate
+	8,  // 15: live.GetSessionResponse.session:type_name -> live.SessionState
+	56, // 16: live.GetStatusResponse.destinations:type_name -> live.GetStatusResponse.DestinationsEntry
+	14, // 17: live.SetConfigRequest.config:type_name -> live.Config
+	14, // 18: live.SetConfigResponse.config:type_name -> live.Config
+	8,  // 19: live.SessionStartResponse.session:type_name -> live.SessionState
+	8,  // 20: live.SessionEndResponse.session:type_name -> live.SessionState
+	8,  // 21: live.SessionResumeResponse.session:type_name -> live.SessionState
+	57, // 22: live.ReconcileResponse.results:type_name -> live.ReconcileResponse.ResultsEntry
+	9,  // 23: live.History.SessionsEntry.value:type_name -> live.SessionHistory
+	12, // 24: live.Config.TokensEntry.value:type_name -> live.TokenConfig
+	18, // 25: live.Outboxes.OutboxesEntry.value:type_name -> live.Outbox
+	22, // 26: live.GetQueueResponse.QueuesEntry.value:type_name -> live.QueueStatus
+	16, // 27: live.GetStatusResponse.DestinationsEntry.value:type_name -> live.DestinationHealth
+	49, // 28: live.ReconcileResponse.ResultsEntry.value:type_name -> live.ReconcileResult
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_live_proto_init() }
+func file_live_proto_init() {
+	if File_live_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_live_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Token); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*WhoamiResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*History); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*IndexedTrackUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*TokenConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*QueuedUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DestinationHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*DestinationHealthEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Outbox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Outboxes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*QueueStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -815,8 +3608,8 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*TokenConfig); i {
+		file_live_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQueueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -827,8 +3620,8 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Config); i {
+		file_live_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*PendingUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -839,8 +3632,8 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetConfigRequest); i {
+		file_live_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPendingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -851,8 +3644,8 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetConfigResponse); i {
+		file_live_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPendingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -863,7 +3656,55 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[5].Exporter = func(v any, i int) any {
+		file_live_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[26].Exporter = func(v any, i int) any {
 			switch v := v.(*SetConfigRequest); i {
 			case 0:
 				return &v.state
@@ -875,7 +3716,7 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[6].Exporter = func(v any, i int) any {
+		file_live_proto_msgTypes[27].Exporter = func(v any, i int) any {
 			switch v := v.(*SetConfigResponse); i {
 			case 0:
 				return &v.state
@@ -887,7 +3728,7 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_live_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*TokenSetRequest); i {
 			case 0:
 				return &v.state
@@ -899,7 +3740,7 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
-		file_live_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_live_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*TokenSetResponse); i {
 			case 0:
 				return &v.state
@@ -911,15 +3752,195 @@ func file_live_proto_init() {
 				return nil
 			}
 		}
+		file_live_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*TestConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*TestConnectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionStartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionEndRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionEndResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionResumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionResumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*HideNextRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*HideNextResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ManualTrackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ManualTrackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_live_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_live_proto_msgTypes[7].OneofWrappers = []any{}
+	file_live_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_live_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   10,
+			NumEnums:      6,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// lockout counts authentication failures per key, locking the key out once
+// there are too many failures within a window.
+type lockout struct {
+	lock        sync.Mutex
+	maxFailures int
+	window      time.Duration
+	duration    time.Duration
+	entries     map[string]*lockoutEntry
+}
+
+type lockoutEntry struct {
+	failures     int
+	firstFailure time.Time
+	lockedUntil  time.Time
+}
+
+func newLockout(cfg LockoutConfig) *lockout {
+	return &lockout{
+		maxFailures: cfg.MaxFailures,
+		window:      cfg.Window,
+		duration:    cfg.Duration,
+		entries:     map[string]*lockoutEntry{},
+	}
+}
+
+// lockedUntil returns when key's lockout ends. If key isn't locked out, the
+// zero time is returned.
+func (l *lockout) lockedUntil(key string, now time.Time) time.Time {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	e, present := l.entries[key]
+	if !present {
+		return time.Time{}
+	}
+	if now.Before(e.lockedUntil) {
+		return e.lockedUntil
+	}
+	if l.expired(e, now) {
+		delete(l.entries, key)
+	}
+	return time.Time{}
+}
+
+// failure records a failure for key. It returns the number of failures in the
+// current window and whether this failure caused a lockout.
+func (l *lockout) failure(key string, now time.Time) (int, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.prune(now)
+	e, present := l.entries[key]
+	if !present || l.expired(e, now) {
+		e = &lockoutEntry{firstFailure: now}
+		l.entries[key] = e
+	}
+	e.failures++
+	if e.failures < l.maxFailures {
+		return e.failures, false
+	}
+	// callers reject locked keys without recording a failure, so attempts
+	// while locked don't extend the lockout
+	e.lockedUntil = now.Add(l.duration)
+	return e.failures, true
+}
+
+// success forgets any failures for key
+func (l *lockout) success(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.entries, key)
+}
+
+// expired reports whether e's failure window and any lockout have passed
+func (l *lockout) expired(e *lockoutEntry, now time.Time) bool {
+	return !now.Before(e.lockedUntil) && now.Sub(e.firstFailure) >= l.window
+}
+
+func (l *lockout) prune(now time.Time) {
+	for key, e := range l.entries {
+		if l.expired(e, now) {
+			delete(l.entries, key)
+		}
+	}
+}
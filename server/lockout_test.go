@@ -0,0 +1,84 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockout(t *testing.T) {
+	t.Parallel()
+
+	l := newLockout(LockoutConfig{
+		MaxFailures: 3,
+		Window:      time.Minute,
+		Duration:    time.Hour,
+	})
+	now := time.Now()
+	key := "192.0.2.1"
+
+	require.True(t, l.lockedUntil(key, now).IsZero(), "unknown key")
+
+	failures, locked := l.failure(key, now)
+	require.Equal(t, 1, failures)
+	require.False(t, locked)
+	failures, locked = l.failure(key, now.Add(time.Second))
+	require.Equal(t, 2, failures)
+	require.False(t, locked)
+	require.True(t, l.lockedUntil(key, now.Add(time.Second)).IsZero(), "below max failures")
+
+	lockTime := now.Add(time.Second * 2)
+	failures, locked = l.failure(key, lockTime)
+	require.Equal(t, 3, failures)
+	require.True(t, locked, "reaching max failures")
+	require.Equal(t, lockTime.Add(time.Hour), l.lockedUntil(key, lockTime))
+	require.True(t, l.lockedUntil("192.0.2.2", lockTime).IsZero(), "other keys unaffected")
+
+	// still locked after the failure window, until the lockout duration passes
+	require.False(t, l.lockedUntil(key, lockTime.Add(time.Minute*30)).IsZero())
+	require.True(t, l.lockedUntil(key, lockTime.Add(time.Hour)).IsZero())
+
+	// the lockout has passed, so counting starts over
+	failures, locked = l.failure(key, lockTime.Add(time.Hour))
+	require.Equal(t, 1, failures)
+	require.False(t, locked)
+}
+
+func TestLockoutWindow(t *testing.T) {
+	t.Parallel()
+
+	l := newLockout(LockoutConfig{
+		MaxFailures: 2,
+		Window:      time.Minute,
+		Duration:    time.Hour,
+	})
+	now := time.Now()
+	key := "192.0.2.1"
+
+	_, locked := l.failure(key, now)
+	require.False(t, locked)
+	// the first failure has aged out of the window
+	failures, locked := l.failure(key, now.Add(time.Minute))
+	require.Equal(t, 1, failures)
+	require.False(t, locked)
+}
+
+func TestLockoutSuccess(t *testing.T) {
+	t.Parallel()
+
+	l := newLockout(LockoutConfig{
+		MaxFailures: 2,
+		Window:      time.Minute,
+		Duration:    time.Hour,
+	})
+	now := time.Now()
+	key := "192.0.2.1"
+
+	_, locked := l.failure(key, now)
+	require.False(t, locked)
+	l.success(key)
+	failures, locked := l.failure(key, now)
+	require.Equal(t, 1, failures, "success resets failures")
+	require.False(t, locked)
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+
+	rateLimitPruneInterval = time.Minute
+)
+
+// rateLimiter is a set of token buckets, one per key
+type rateLimiter struct {
+	lock      sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter creates a limiter from cfg. If the limit is disabled with a
+// negative rate, or has no rate because defaults weren't applied, nil is
+// returned. A nil *rateLimiter allows everything.
+func newRateLimiter(cfg RateLimit) *rateLimiter {
+	if cfg.Rate <= 0 {
+		return nil
+	}
+	burst := float64(cfg.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:    cfg.Rate,
+		burst:   burst,
+		buckets: map[string]*bucket{},
+	}
+}
+
+// allow takes a token from key's bucket. If there are none available, false is
+// returned along with how long until one will be.
+func (rl *rateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	if rl == nil {
+		return true, 0
+	}
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	rl.prune(now)
+
+	b, present := rl.buckets[key]
+	if !present {
+		b = &bucket{tokens: rl.burst, last: now}
+		rl.buckets[key] = b
+	}
+	b.tokens = math.Min(rl.burst, b.tokens+now.Sub(b.last).Seconds()*rl.rate)
+	b.last = now
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / rl.rate * float64(time.Second))
+		return false, wait
+	}
+	b.tokens--
+	return true, 0
+}
+
+// prune drops buckets that have refilled completely. They're equivalent to
+// having no bucket at all.
+func (rl *rateLimiter) prune(now time.Time) {
+	if now.Sub(rl.lastPrune) < rateLimitPruneInterval {
+		return
+	}
+	rl.lastPrune = now
+	full := time.Duration(rl.burst / rl.rate * float64(time.Second))
+	for key, b := range rl.buckets {
+		if now.Sub(b.last) >= full {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+type rateLimiters struct {
+	issuePerIP            *rateLimiter
+	trackUpdatePerIP      *rateLimiter
+	trackUpdatePerSubject *rateLimiter
+}
+
+func newRateLimiters(cfg RateLimitsConfig) *rateLimiters {
+	return &rateLimiters{
+		issuePerIP:            newRateLimiter(cfg.IssuePerIP),
+		trackUpdatePerIP:      newRateLimiter(cfg.TrackUpdatePerIP),
+		trackUpdatePerSubject: newRateLimiter(cfg.TrackUpdatePerSubject),
+	}
+}
+
+// remoteIP gets the address of the client, without a port. If the server is
+// configured with a real IP header, e.g. behind a reverse proxy, that's used.
+// Clients can put anything in the header, so the address is taken from the
+// entry added by the furthest trusted proxy, counting from the right.
+func (srv *Server) remoteIP(r *http.Request) string {
+	if srv.realIPHeader != "" {
+		var entries []string
+		// proxies may append another header rather than extend the last one
+		for _, v := range r.Header.Values(srv.realIPHeader) {
+			entries = append(entries, strings.Split(v, ",")...)
+		}
+		proxies := max(srv.proxies, 1)
+		if len(entries) >= proxies {
+			if ip := strings.TrimSpace(entries[len(entries)-proxies]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// rateLimit wraps next, throttling the issue and ingest endpoints
+func (srv *Server) rateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+		now := time.Now()
+		ip := srv.remoteIP(r)
+		switch {
+		case r.URL.Path == "/_issue" || r.URL.Path == "/_redeem" || r.URL.Path == "/_refresh":
+			if ok, wait := srv.limits.issuePerIP.allow(ip, now); !ok {
+				srv.throttled(w, r, wait, "ip", ip)
+				return
+			}
+		case strings.HasPrefix(r.URL.Path, "/_trackUpdate/"):
+			if ok, wait := srv.limits.trackUpdatePerIP.allow(ip, now); !ok {
+				srv.throttled(w, r, wait, "ip", ip)
+				return
+			}
+			// an invalid token is rejected by the handler, don't limit on it
+			if userID, err := srv.auth.parse(r.Header.Get(headerToken)); err == nil {
+				if ok, wait := srv.limits.trackUpdatePerSubject.allow(userID, now); !ok {
+					srv.throttled(w, r, wait, "user_id", userID)
+					return
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (srv *Server) throttled(w http.ResponseWriter, r *http.Request, wait time.Duration, limitKey, limitValue string) {
+	retryAfter := int(math.Ceil(wait.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	w.Header().Set(headerRetryAfter, strconv.Itoa(retryAfter))
+	defaultHTTPError(w, http.StatusTooManyRequests)
+	srv.logger.Warn("rate limited",
+		"remote", r.RemoteAddr,
+		"path", r.URL.Path,
+		limitKey, limitValue,
+		"retry_after", retryAfter,
+	)
+}
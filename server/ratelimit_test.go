@@ -0,0 +1,91 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteIP(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		header  string
+		proxies int
+		values  []string
+		want    string
+	}{
+		{name: "no header configured", values: []string{"192.0.2.1"}, want: "198.51.100.1"},
+		{name: "header missing", header: "X-Forwarded-For", want: "198.51.100.1"},
+		{name: "single entry", header: "X-Forwarded-For", values: []string{"192.0.2.1"}, want: "192.0.2.1"},
+		{name: "spoofed entry", header: "X-Forwarded-For", values: []string{"203.0.113.1, 192.0.2.1"}, want: "192.0.2.1"},
+		{name: "repeated header", header: "X-Forwarded-For", values: []string{"203.0.113.1", "192.0.2.1"}, want: "192.0.2.1"},
+		{name: "two proxies", header: "X-Forwarded-For", proxies: 2, values: []string{"203.0.113.1, 192.0.2.1, 10.0.0.1"}, want: "192.0.2.1"},
+		{name: "too few entries", header: "X-Forwarded-For", proxies: 2, values: []string{"192.0.2.1"}, want: "198.51.100.1"},
+		{name: "empty entry", header: "X-Forwarded-For", values: []string{"192.0.2.1, "}, want: "198.51.100.1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			srv := &Server{realIPHeader: tc.header, proxies: tc.proxies}
+			r := httptest.NewRequest("POST", "/_issue", nil)
+			r.RemoteAddr = "198.51.100.1:1234"
+			for _, v := range tc.values {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+			require.Equal(t, tc.want, srv.remoteIP(r))
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	rl := newRateLimiter(RateLimit{Rate: 0.5, Burst: 2})
+	now := time.Now()
+
+	ok, _ := rl.allow("a", now)
+	require.True(t, ok)
+	ok, _ = rl.allow("a", now)
+	require.True(t, ok, "within burst")
+	ok, wait := rl.allow("a", now)
+	require.False(t, ok, "burst used up")
+	require.Equal(t, time.Second*2, wait)
+	ok, _ = rl.allow("b", now)
+	require.True(t, ok, "other keys have their own bucket")
+
+	ok, wait = rl.allow("a", now.Add(time.Second))
+	require.False(t, ok, "half refilled")
+	require.Equal(t, time.Second, wait)
+	ok, _ = rl.allow("a", now.Add(time.Second*2))
+	require.True(t, ok, "refilled")
+
+	var disabled *rateLimiter
+	ok, _ = disabled.allow("a", now)
+	require.True(t, ok, "nil allows everything")
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newTestServer(t)
+	issue := func(remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/_issue", nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		return w
+	}
+	// the default issue limit has a burst of 5 and refills every 10 seconds
+	for i := range 5 {
+		w := issue("192.0.2.1:1234")
+		require.Equal(t, http.StatusBadRequest, w.Code, "request %d reaches the handler", i+1)
+	}
+	w := issue("192.0.2.1:1234")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "10", w.Header().Get(headerRetryAfter))
+	require.Equal(t, http.StatusBadRequest, issue("192.0.2.2:1234").Code, "other clients unaffected")
+}
@@ -9,9 +9,12 @@ import (
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/autonomouskoi/trackstar-live/server/formats"
 )
 
 const (
@@ -23,28 +26,56 @@ const (
 )
 
 type Server struct {
-	handler http.Handler
-	auth    *jwtAuth
-	logger  *slog.Logger
-	store   Store
-	subs    *Subs
+	handler      http.Handler
+	auth         *jwtAuth
+	share        *shareSigner
+	limits       *rateLimiters
+	issueLockout *lockout
+	logger       *slog.Logger
+	store        Store
+	subs         *Subs
+	myURL        string
+	privateSets  bool
+	realIPHeader string
+	proxies      int
+	// started is when the server started, which an empty feed reports as
+	// when it was updated
+	started time.Time
 }
 
 func New(cfg *ServerConfig, logger *slog.Logger, store Store) (*Server, error) {
 	mux := http.NewServeMux()
 
 	srv := &Server{
-		logger: logger,
-		auth:   newJWTAuth(cfg),
-		store:  store,
-		subs:   NewSubs(),
+		logger:       logger,
+		auth:         newJWTAuth(cfg),
+		share:        newShareSigner(cfg),
+		limits:       newRateLimiters(cfg.RateLimits),
+		issueLockout: newLockout(cfg.IssueLockout),
+		store:        store,
+		subs:         NewSubs(),
+		myURL:        cfg.MyURL,
+		privateSets:  cfg.PrivateSets,
+		realIPHeader: cfg.RealIPHeader,
+		proxies:      cfg.TrustedProxies,
+		started:      time.Now(),
 	}
 
 	mux.HandleFunc("POST /_issue", srv.handleIssue)
+	mux.HandleFunc("POST /_redeem", srv.handleRedeem)
+	mux.HandleFunc("POST /_refresh", srv.handleRefresh)
+	mux.HandleFunc("POST /_ping", srv.handlePing)
+	mux.HandleFunc("GET /_whoami", srv.handleWhoami)
 	mux.HandleFunc("POST /_trackUpdate/{userID}/{started}", srv.addTrackUpdate)
 	mux.HandleFunc("GET /_trackUpdate/{userID}", srv.sessionsList)
 	mux.HandleFunc("GET /_trackUpdate/{userID}/{started}", srv.sessionGet)
 	mux.HandleFunc("GET /_sub/{userID}", srv.sub)
+	mux.HandleFunc("POST /_share/{userID}/{started}", srv.shareCreate)
+	mux.HandleFunc("GET /_archive/{userID}", srv.archiveExport)
+	mux.HandleFunc("POST /_archive/{userID}", srv.archiveImport)
+	mux.HandleFunc("POST /_import/{userID}", srv.handleImport)
+	mux.HandleFunc("GET /u/{userID}/feed.xml", srv.handleFeed("application/atom+xml; charset=utf-8", formats.WriteAtom))
+	mux.HandleFunc("GET /u/{userID}/feed.json", srv.handleFeed("application/feed+json; charset=utf-8", formats.WriteJSONFeed))
 
 	indexPath := filepath.Join(cfg.HTMLPath, "index.html")
 	mux.HandleFunc("GET /u/", func(w http.ResponseWriter, r *http.Request) {
@@ -54,7 +85,7 @@ func New(cfg *ServerConfig, logger *slog.Logger, store Store) (*Server, error) {
 
 	// mux wrappers here
 
-	srv.handler = mux
+	srv.handler = srv.rateLimit(mux)
 
 	return srv, nil
 }
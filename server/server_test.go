@@ -0,0 +1,30 @@
+package server
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonomouskoi/trackstar-live/server/store"
+	"github.com/autonomouskoi/trackstar-live/server/store/sqlite3"
+)
+
+// newTestServer creates a server with an in-memory store
+func newTestServer(t *testing.T) (*Server, *store.Store) {
+	t.Helper()
+	db, err := sqlite3.New(":memory:")
+	require.NoError(t, err, "creating database")
+	st := store.New(db)
+	cfg := &ServerConfig{
+		MyURL:         "https://live.example.com",
+		MyKeyInput:    "test-key",
+		TokenLifetime: time.Hour,
+	}
+	cfg.setDefaults()
+	srv, err := New(cfg, slog.New(slog.NewTextHandler(io.Discard, nil)), st)
+	require.NoError(t, err)
+	return srv, st
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	paramShareExpires = "exp"
+	paramShareSig     = "sig"
+)
+
+// shareSigner mints and verifies HMAC signatures granting access to a single
+// set.
+type shareSigner struct {
+	key []byte
+}
+
+// newShareSigner derives the signer's key from the one tokens are signed
+// with, so share links and tokens can't be mistaken for each other
+func newShareSigner(cfg *ServerConfig) *shareSigner {
+	mac := hmac.New(sha256.New, processKey(cfg.MyKeyInput))
+	mac.Write([]byte("share-link"))
+	return &shareSigner{
+		key: mac.Sum(nil),
+	}
+}
+
+func (ss *shareSigner) mac(userID string, started, expires int64) []byte {
+	mac := hmac.New(sha256.New, ss.key)
+	fmt.Fprintf(mac, "share\x00%s\x00%d\x00%d", userID, started, expires)
+	return mac.Sum(nil)
+}
+
+// sign returns the signature for userID's set started at started. An expires
+// value of 0 means the signature never expires.
+func (ss *shareSigner) sign(userID string, started, expires int64) string {
+	return base64.RawURLEncoding.EncodeToString(ss.mac(userID, started, expires))
+}
+
+// verify checks that sig is a valid, unexpired signature for the set.
+func (ss *shareSigner) verify(userID string, started, expires int64, sig string, now time.Time) bool {
+	if expires != 0 && now.UnixMilli() >= expires {
+		return false
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, ss.mac(userID, started, expires))
+}
+
+// shareAllowed reports whether r may view userID's set started at started.
+// The owner and holders of a valid share signature can always view a set.
+// Anyone else can view it unless the server is configured with private sets
+// or the owner has unlisted it.
+func (srv *Server) shareAllowed(r *http.Request, userID string, started int64) (bool, error) {
+	if srv.shareSigned(r, userID, started) {
+		return true, nil
+	}
+	// the owner can always see their own sets
+	if subject, err := srv.authenticate(r); err == nil && subject == userID {
+		return true, nil
+	}
+	if srv.privateSets {
+		return false, nil
+	}
+	unlisted, err := srv.isUnlisted(r.Context(), userID, started)
+	return !unlisted, err
+}
+
+// shareSigned reports whether r carries a valid share signature for userID's
+// set started at started
+func (srv *Server) shareSigned(r *http.Request, userID string, started int64) bool {
+	sig := r.FormValue(paramShareSig)
+	if sig == "" {
+		return false
+	}
+	var expires int64
+	if expiresStr := r.FormValue(paramShareExpires); expiresStr != "" {
+		var err error
+		if expires, err = strconv.ParseInt(expiresStr, 10, 64); err != nil {
+			return false
+		}
+	}
+	return srv.share.verify(userID, started, expires, sig, time.Now())
+}
+
+// isUnlisted reports whether userID has unlisted their set started at started
+func (srv *Server) isUnlisted(ctx context.Context, userID string, started int64) (bool, error) {
+	unlisted, err := srv.store.SessionsUnlisted(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(unlisted, started), nil
+}
+
+// listed removes userID's unlisted sets from sessions
+func (srv *Server) listed(ctx context.Context, userID string, sessions []int64) ([]int64, error) {
+	unlisted, err := srv.store.SessionsUnlisted(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(sessions, func(started int64) bool {
+		return slices.Contains(unlisted, started)
+	}), nil
+}
+
+func (srv *Server) shareCreate(w http.ResponseWriter, r *http.Request) {
+	userID, err := srv.authenticate(r)
+	if err != nil {
+		defaultHTTPError(w, http.StatusForbidden)
+		srv.logger.Warn("bad token for share",
+			"remote", r.RemoteAddr,
+			"error", err.Error(),
+		)
+		return
+	}
+	if pathUserID := r.PathValue("userID"); pathUserID != userID {
+		http.Error(w, "token mismatch", http.StatusForbidden)
+		srv.logger.Warn("token mismatch",
+			"path_user_id", pathUserID,
+			"token_user_id", userID,
+		)
+		return
+	}
+	startedStr := r.PathValue("started")
+	started, err := strconv.ParseInt(startedStr, 10, 64)
+	if err != nil {
+		http.Error(w, "parsing started: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var expires int64
+	if expiresInStr := r.PostFormValue("expires_in"); expiresInStr != "" {
+		expiresIn, err := time.ParseDuration(expiresInStr)
+		if err != nil || expiresIn <= 0 {
+			http.Error(w, "bad expires_in", http.StatusBadRequest)
+			return
+		}
+		expires = time.Now().Add(expiresIn).UnixMilli()
+	}
+
+	// unlisted sets are only viewable by the owner and through share links
+	if unlistedStr := r.PostFormValue("unlisted"); unlistedStr != "" {
+		unlisted, err := strconv.ParseBool(unlistedStr)
+		if err != nil {
+			http.Error(w, "bad unlisted", http.StatusBadRequest)
+			return
+		}
+		if err := srv.store.SessionSetUnlisted(r.Context(), userID, started, unlisted); err != nil {
+			defaultHTTPError(w, http.StatusInternalServerError)
+			srv.logger.Error("setting unlisted",
+				"remote", r.RemoteAddr,
+				"user_id", userID,
+				"started", started,
+				"error", err.Error(),
+			)
+			return
+		}
+	}
+	unlisted, err := srv.isUnlisted(r.Context(), userID, started)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("checking unlisted",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"started", started,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	u, err := url.Parse(srv.myURL)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("parsing server URL", "url", srv.myURL, "error", err.Error())
+		return
+	}
+	u.Path = path.Join(u.Path, "u", userID, startedStr)
+	q := url.Values{}
+	if expires != 0 {
+		q.Set(paramShareExpires, strconv.FormatInt(expires, 10))
+	}
+	q.Set(paramShareSig, srv.share.sign(userID, started, expires))
+	u.RawQuery = q.Encode()
+
+	srv.logger.Info("created share link",
+		"remote", r.RemoteAddr,
+		"user_id", userID,
+		"started", started,
+		"expires", expires,
+		"unlisted", unlisted,
+	)
+	srv.sendJSON(w, map[string]any{
+		"url":      u.String(),
+		"expires":  expires,
+		"unlisted": unlisted,
+	})
+}
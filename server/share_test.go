@@ -0,0 +1,88 @@
+package server
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareSigner(t *testing.T) {
+	t.Parallel()
+
+	ss := newShareSigner(&ServerConfig{MyKeyInput: "test-key"})
+	now := time.Now()
+	started := now.Add(-time.Hour).UnixMilli()
+	expires := now.Add(time.Hour).UnixMilli()
+
+	sig := ss.sign("test-user", started, expires)
+	require.True(t, ss.verify("test-user", started, expires, sig, now))
+	require.False(t, ss.verify("test-user", started, expires, sig, now.Add(time.Hour)), "expired")
+	require.False(t, ss.verify("test-user", started, expires+1, sig, now), "extended expiry")
+	require.False(t, ss.verify("test-user", started+1, expires, sig, now), "other set")
+	require.False(t, ss.verify("other-user", started, expires, sig, now), "other user")
+
+	tampered := []byte(sig)
+	tampered[0] ^= 1
+	require.False(t, ss.verify("test-user", started, expires, string(tampered), now), "tampered sig")
+	require.False(t, ss.verify("test-user", started, expires, "not base64!", now), "garbage sig")
+
+	forever := ss.sign("test-user", started, 0)
+	require.True(t, ss.verify("test-user", started, 0, forever, now.Add(time.Hour*24*365)), "no expiry")
+
+	require.NotEqual(t, processKey("test-key"), ss.key, "not the token key")
+
+	other := newShareSigner(&ServerConfig{MyKeyInput: "other-key"})
+	require.False(t, other.verify("test-user", started, expires, sig, now), "other key")
+}
+
+func TestShareAllowed(t *testing.T) {
+	t.Parallel()
+
+	srv, st := newTestServer(t)
+
+	const listed, unlisted = 1000, 2000
+	require.NoError(t, st.SessionSetUnlisted(t.Context(), "test-user", unlisted, true))
+
+	token, err := srv.auth.issue("test-user")
+	require.NoError(t, err)
+	otherToken, err := srv.auth.issue("other-user")
+	require.NoError(t, err)
+
+	allowed := func(started int64, token string, q url.Values) bool {
+		t.Helper()
+		r := httptest.NewRequest("GET", "/_trackUpdate/test-user/"+strconv.FormatInt(started, 10)+"?"+q.Encode(), nil)
+		if token != "" {
+			r.Header.Set(headerToken, token)
+		}
+		ok, err := srv.shareAllowed(r, "test-user", started)
+		require.NoError(t, err)
+		return ok
+	}
+	signed := func(started, expires int64) url.Values {
+		q := url.Values{}
+		q.Set(paramShareSig, srv.share.sign("test-user", started, expires))
+		if expires != 0 {
+			q.Set(paramShareExpires, strconv.FormatInt(expires, 10))
+		}
+		return q
+	}
+	expired := time.Now().Add(-time.Minute).UnixMilli()
+
+	require.True(t, allowed(listed, "", nil), "listed set")
+	require.False(t, allowed(unlisted, "", nil), "unlisted set")
+	require.False(t, allowed(unlisted, otherToken.RawToken, nil), "unlisted set, other user")
+	require.True(t, allowed(unlisted, token.RawToken, nil), "unlisted set, owner")
+	require.True(t, allowed(unlisted, "", signed(unlisted, 0)), "unlisted set, signed")
+	require.False(t, allowed(unlisted, "", signed(unlisted, expired)), "unlisted set, expired")
+	require.False(t, allowed(unlisted, "", signed(listed, 0)), "unlisted set, other set's sig")
+
+	srv.privateSets = true
+	require.False(t, allowed(listed, "", nil), "private set")
+	require.True(t, allowed(listed, token.RawToken, nil), "private set, owner")
+	require.True(t, allowed(listed, "", signed(listed, 0)), "private set, signed")
+	require.False(t, allowed(listed, "", signed(listed, expired)), "private set, expired")
+}
@@ -10,6 +10,26 @@ type Store interface {
 	SessionsList(ctx context.Context, userID string) ([]int64, error)
 	SessionGet(ctx context.Context, userID string, started int64) ([]*trackstar.TrackUpdate, error)
 	SessionDelete(ctx context.Context, userID string, started int64) error
+	// SessionSetUnlisted sets whether userID's session started at started is
+	// unlisted
+	SessionSetUnlisted(ctx context.Context, userID string, started int64, unlisted bool) error
+	// SessionsUnlisted returns when userID's unlisted sessions started
+	SessionsUnlisted(ctx context.Context, userID string) ([]int64, error)
 
+	// AddTrackUpdate stores a track update. If the session already has an
+	// update played at the same time, store.ErrDuplicate is returned.
 	AddTrackUpdate(ctx context.Context, userID string, sessionStarted int64, tu *trackstar.TrackUpdate) error
+
+	// InviteCreate stores a single-use invite code for userID. An expires value
+	// of 0 means the invite never expires.
+	InviteCreate(ctx context.Context, code, userID string, expires int64) error
+	// InviteRedeem marks an unused, unexpired invite as redeemed and returns
+	// its user ID. store.ErrNotFound is returned if there's no such invite.
+	InviteRedeem(ctx context.Context, code string, now int64) (string, error)
+
+	// TokensRevoke revokes every token for userID issued before before
+	TokensRevoke(ctx context.Context, userID string, before int64) error
+	// TokensRevokedBefore returns the time before which userID's tokens are
+	// revoked, or 0 if they've never been revoked
+	TokensRevokedBefore(ctx context.Context, userID string) (int64, error)
 }
@@ -1,5 +1,8 @@
 package sqlite3
 
+// schemas are applied in order, each bringing the database to the next version
+var schemas = []string{schema1, schema2, schema3, schema4}
+
 const schema1 = `
 CREATE TABLE track_updates (
 	user_id      TEXT,
@@ -20,3 +23,34 @@ CREATE UNIQUE INDEX unique_updates ON track_updates (user_id, started, played_wh
 PRAGMA user_version=1;
 PRAGMA optimize;
 `
+
+const schema2 = `
+CREATE TABLE invites (
+	code      TEXT PRIMARY KEY,
+	user_id   TEXT,
+	created   INT,
+	expires   INT,
+	redeemed  INT
+);
+
+PRAGMA user_version=2;
+`
+
+const schema3 = `
+CREATE TABLE token_revocations (
+	user_id         TEXT PRIMARY KEY,
+	revoked_before  INT
+);
+
+PRAGMA user_version=3;
+`
+
+const schema4 = `
+CREATE TABLE unlisted_sessions (
+	user_id  TEXT,
+	started  INT,
+	PRIMARY KEY (user_id, started)
+);
+
+PRAGMA user_version=4;
+`
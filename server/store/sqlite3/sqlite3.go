@@ -25,9 +25,9 @@ func (sdb SQLite3) init() error {
 	if err := sdb.Get(&version, `PRAGMA user_version`); err != nil {
 		return fmt.Errorf("getting database version: %w", err)
 	}
-	if version != 1 {
-		if _, err := sdb.Exec(schema1); err != nil {
-			return fmt.Errorf("initializing database: %w", err)
+	for ; version < len(schemas); version++ {
+		if _, err := sdb.Exec(schemas[version]); err != nil {
+			return fmt.Errorf("migrating database to version %d: %w", version+1, err)
 		}
 	}
 	return nil
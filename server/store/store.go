@@ -4,10 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	trackstar "github.com/autonomouskoi/trackstar/pb"
 )
 
+var (
+	// ErrNotFound is returned when the requested item doesn't exist
+	ErrNotFound = errors.New("not found")
+	// ErrDuplicate is returned when adding an item that's already present
+	ErrDuplicate = errors.New("duplicate")
+)
+
 type DB interface {
 	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
 	Rebind(string) string
@@ -78,6 +86,42 @@ func (s *Store) SessionDelete(_ context.Context, userID string, started int64) e
 	return errors.New("not implemented")
 }
 
+type unlistedSession struct {
+	UserID  string `db:"user_id"`
+	Started int64  `db:"started"`
+}
+
+func (s *Store) SessionSetUnlisted(ctx context.Context, userID string, started int64, unlisted bool) error {
+	stmt := s.db.Rebind(`
+DELETE FROM unlisted_sessions WHERE user_id = :user_id AND started = :started`)
+	if unlisted {
+		stmt = s.db.Rebind(`
+INSERT INTO unlisted_sessions (
+	user_id,
+	started
+) VALUES (
+	:user_id,
+	:started
+) ON CONFLICT DO NOTHING`)
+	}
+	_, err := s.db.NamedExecContext(ctx, stmt, &unlistedSession{
+		UserID:  userID,
+		Started: started,
+	})
+	return err
+}
+
+func (s *Store) SessionsUnlisted(ctx context.Context, userID string) ([]int64, error) {
+	query := s.db.Rebind(`
+SELECT started FROM unlisted_sessions
+	WHERE user_id = ?
+	ORDER BY started DESC
+`)
+	sessions := []int64{}
+	err := s.db.SelectContext(ctx, &sessions, query, userID)
+	return sessions, err
+}
+
 func (s *Store) AddTrackUpdate(ctx context.Context, userID string, sessionStarted int64, tu *trackstar.TrackUpdate) error {
 	stmt := s.db.Rebind(`
 INSERT INTO track_updates (
@@ -96,8 +140,8 @@ INSERT INTO track_updates (
 	:title,
 	:played_when,
 	:idx
-)`)
-	_, err := s.db.NamedExecContext(ctx, stmt, &trackUpdate{
+) ON CONFLICT DO NOTHING`)
+	result, err := s.db.NamedExecContext(ctx, stmt, &trackUpdate{
 		UserID:  userID,
 		Started: sessionStarted,
 		DeckID:  tu.GetDeckId(),
@@ -106,5 +150,108 @@ INSERT INTO track_updates (
 		When:    tu.GetWhen(),
 		Index:   tu.GetIndex(),
 	})
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrDuplicate
+	}
+	return nil
+}
+
+type invite struct {
+	Code     string `db:"code"`
+	UserID   string `db:"user_id"`
+	Created  int64  `db:"created"`
+	Expires  int64  `db:"expires"`
+	Redeemed int64  `db:"redeemed"`
+}
+
+func (s *Store) InviteCreate(ctx context.Context, code, userID string, expires int64) error {
+	stmt := s.db.Rebind(`
+INSERT INTO invites (
+	code,
+	user_id,
+	created,
+	expires,
+	redeemed
+) VALUES (
+	:code,
+	:user_id,
+	:created,
+	:expires,
+	0
+)`)
+	_, err := s.db.NamedExecContext(ctx, stmt, &invite{
+		Code:    code,
+		UserID:  userID,
+		Created: time.Now().UnixMilli(),
+		Expires: expires,
+	})
 	return err
 }
+
+func (s *Store) InviteRedeem(ctx context.Context, code string, now int64) (string, error) {
+	stmt := s.db.Rebind(`
+UPDATE invites SET redeemed = :redeemed
+	WHERE code = :code
+	AND redeemed = 0
+	AND (expires = 0 OR expires > :redeemed)
+`)
+	result, err := s.db.NamedExecContext(ctx, stmt, &invite{
+		Code:     code,
+		Redeemed: now,
+	})
+	if err != nil {
+		return "", err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return "", err
+	} else if n != 1 {
+		return "", ErrNotFound
+	}
+	query := s.db.Rebind(`SELECT user_id FROM invites WHERE code = ?`)
+	userIDs := []string{}
+	if err := s.db.SelectContext(ctx, &userIDs, query, code); err != nil {
+		return "", err
+	}
+	if len(userIDs) != 1 {
+		return "", ErrNotFound
+	}
+	return userIDs[0], nil
+}
+
+type tokenRevocation struct {
+	UserID        string `db:"user_id"`
+	RevokedBefore int64  `db:"revoked_before"`
+}
+
+func (s *Store) TokensRevoke(ctx context.Context, userID string, before int64) error {
+	stmt := s.db.Rebind(`
+INSERT INTO token_revocations (
+	user_id,
+	revoked_before
+) VALUES (
+	:user_id,
+	:revoked_before
+) ON CONFLICT (user_id) DO UPDATE SET revoked_before = excluded.revoked_before`)
+	_, err := s.db.NamedExecContext(ctx, stmt, &tokenRevocation{
+		UserID:        userID,
+		RevokedBefore: before,
+	})
+	return err
+}
+
+func (s *Store) TokensRevokedBefore(ctx context.Context, userID string) (int64, error) {
+	query := s.db.Rebind(`SELECT revoked_before FROM token_revocations WHERE user_id = ?`)
+	revokedBefore := []int64{}
+	if err := s.db.SelectContext(ctx, &revokedBefore, query, userID); err != nil {
+		return 0, err
+	}
+	if len(revokedBefore) == 0 {
+		return 0, nil
+	}
+	return revokedBefore[0], nil
+}
@@ -21,8 +21,8 @@ func TestSessions(t *testing.T) {
 
 	userID := "test-user"
 
-	store := store.New(db)
-	sessions, err := store.SessionsList(ctx, userID)
+	st := store.New(db)
+	sessions, err := st.SessionsList(ctx, userID)
 	require.NoError(t, err)
 	require.Empty(t, sessions)
 
@@ -41,13 +41,14 @@ func TestSessions(t *testing.T) {
 		When:  trackWhen,
 		Index: 1,
 	}
-	require.NoError(t, store.AddTrackUpdate(ctx, userID, sessionStarted, tu), "adding update")
+	require.NoError(t, st.AddTrackUpdate(ctx, userID, sessionStarted, tu), "adding update")
+	require.ErrorIs(t, st.AddTrackUpdate(ctx, userID, sessionStarted, tu), store.ErrDuplicate, "adding duplicate")
 
-	sessions, err = store.SessionsList(ctx, userID)
+	sessions, err = st.SessionsList(ctx, userID)
 	require.NoError(t, err)
 	require.Equal(t, []int64{sessionStarted}, sessions)
 
-	updates, err := store.SessionGet(ctx, userID, sessionStarted)
+	updates, err := st.SessionGet(ctx, userID, sessionStarted)
 	require.NoError(t, err, "getting session")
 	require.Len(t, updates, 1)
 	require.Equal(t, deckID, updates[0].GetDeckId())
@@ -56,5 +57,89 @@ func TestSessions(t *testing.T) {
 	require.Equal(t, artist, updates[0].GetTrack().GetArtist())
 	require.Equal(t, title, updates[0].GetTrack().GetTitle())
 
-	require.ErrorContains(t, store.SessionDelete(ctx, userID, sessionStarted), "not implemented")
+	require.ErrorContains(t, st.SessionDelete(ctx, userID, sessionStarted), "not implemented")
+}
+
+func TestInvites(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	db, err := sqlite3.New(":memory:")
+	require.NoError(t, err, "creating database")
+
+	st := store.New(db)
+	now := time.Now().UnixMilli()
+
+	require.NoError(t, st.InviteCreate(ctx, "code-1", "test-user", 0))
+	require.NoError(t, st.InviteCreate(ctx, "code-2", "other-user", now-1000))
+
+	userID, err := st.InviteRedeem(ctx, "code-1", now)
+	require.NoError(t, err, "redeeming invite")
+	require.Equal(t, "test-user", userID)
+
+	_, err = st.InviteRedeem(ctx, "code-1", now)
+	require.ErrorIs(t, err, store.ErrNotFound, "redeeming twice")
+
+	_, err = st.InviteRedeem(ctx, "code-2", now)
+	require.ErrorIs(t, err, store.ErrNotFound, "redeeming expired")
+
+	_, err = st.InviteRedeem(ctx, "no-such-code", now)
+	require.ErrorIs(t, err, store.ErrNotFound, "redeeming unknown")
+}
+
+func TestTokenRevocations(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	db, err := sqlite3.New(":memory:")
+	require.NoError(t, err, "creating database")
+
+	st := store.New(db)
+	now := time.Now().UnixMilli()
+
+	before, err := st.TokensRevokedBefore(ctx, "test-user")
+	require.NoError(t, err)
+	require.Zero(t, before, "never revoked")
+
+	require.NoError(t, st.TokensRevoke(ctx, "test-user", now))
+	before, err = st.TokensRevokedBefore(ctx, "test-user")
+	require.NoError(t, err)
+	require.Equal(t, now, before)
+
+	require.NoError(t, st.TokensRevoke(ctx, "test-user", now+1000), "revoking again")
+	before, err = st.TokensRevokedBefore(ctx, "test-user")
+	require.NoError(t, err)
+	require.Equal(t, now+1000, before)
+
+	before, err = st.TokensRevokedBefore(ctx, "other-user")
+	require.NoError(t, err)
+	require.Zero(t, before, "other users unaffected")
+}
+
+func TestSessionsUnlisted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	db, err := sqlite3.New(":memory:")
+	require.NoError(t, err, "creating database")
+
+	st := store.New(db)
+
+	unlisted, err := st.SessionsUnlisted(ctx, "test-user")
+	require.NoError(t, err)
+	require.Empty(t, unlisted)
+
+	require.NoError(t, st.SessionSetUnlisted(ctx, "test-user", 1, true))
+	require.NoError(t, st.SessionSetUnlisted(ctx, "test-user", 2, true))
+	require.NoError(t, st.SessionSetUnlisted(ctx, "test-user", 2, true), "unlisting twice")
+	require.NoError(t, st.SessionSetUnlisted(ctx, "test-user", 1, false))
+	require.NoError(t, st.SessionSetUnlisted(ctx, "test-user", 3, false), "listing a listed session")
+
+	unlisted, err = st.SessionsUnlisted(ctx, "test-user")
+	require.NoError(t, err)
+	require.Equal(t, []int64{2}, unlisted)
+
+	unlisted, err = st.SessionsUnlisted(ctx, "other-user")
+	require.NoError(t, err)
+	require.Empty(t, unlisted, "other users unaffected")
 }
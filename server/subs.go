@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/coder/websocket"
@@ -15,6 +16,8 @@ type TrackUpdate struct {
 	UserID  string                 `json:"user_id"`
 	Session int64                  `json:"started"`
 	Update  *trackstar.TrackUpdate `json:"update"`
+	// Unlisted is set for updates to sets only shared by link
+	Unlisted bool `json:"-"`
 }
 
 type Subs struct {
@@ -74,6 +77,35 @@ func (s *Subs) Send(tu *TrackUpdate) {
 func (srv *Server) sub(w http.ResponseWriter, r *http.Request) {
 	userID := r.PathValue("userID")
 
+	// a subscriber to a single set needs access to it. With private sets a
+	// subscriber must pick a set.
+	var started int64
+	if startedStr := r.FormValue("started"); startedStr != "" || srv.privateSets {
+		var err error
+		started, err = strconv.ParseInt(startedStr, 10, 64)
+		if err != nil {
+			defaultHTTPError(w, http.StatusForbidden)
+			return
+		}
+		allowed, err := srv.shareAllowed(r, userID, started)
+		if err != nil {
+			defaultHTTPError(w, http.StatusInternalServerError)
+			srv.logger.Error("checking share",
+				"remote", r.RemoteAddr,
+				"user_id", userID,
+				"started", started,
+				"error", err.Error(),
+			)
+			return
+		}
+		if !allowed {
+			defaultHTTPError(w, http.StatusForbidden)
+			return
+		}
+	}
+	subject, err := srv.authenticate(r)
+	owner := err == nil && subject == userID
+
 	c, err := websocket.Accept(w, r, nil)
 	if err != nil {
 		defaultHTTPError(w, http.StatusInternalServerError)
@@ -97,6 +129,13 @@ func (srv *Server) sub(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	for tu := range in {
+		if started != 0 && tu.Session != started {
+			continue
+		}
+		// following all of a user's sets skips the unlisted ones
+		if started == 0 && tu.Unlisted && !owner {
+			continue
+		}
 		srv.logger.Debug("sending track update to client",
 			"remote", r.RemoteAddr,
 			"user_id", userID,
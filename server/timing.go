@@ -0,0 +1,60 @@
+package server
+
+import (
+	"time"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+const (
+	// playingTimeout is how long after the latest track started a set is
+	// still considered live
+	playingTimeout = 30 * time.Minute
+	// mixWindow is how long a track keeps playing on one deck after a track
+	// starts on another, while it's mixed out
+	mixWindow = time.Minute
+)
+
+// trackTiming is derived from when a session's tracks were played. Times are
+// in milliseconds.
+type trackTiming struct {
+	// Offset is how far into the session the track started
+	Offset int64 `json:"offset"`
+	// UntilNext is how long after the track the next one started, on any
+	// deck. It's omitted for the latest track.
+	UntilNext *int64 `json:"untilNext,omitempty"`
+	// Playing is set for tracks that are playing now. During a mix tracks on
+	// several decks are playing.
+	Playing bool `json:"playing"`
+}
+
+// sessionTiming derives the timing of a session's updates, in the order they
+// were played, as of now
+func sessionTiming(started int64, updates []*trackstar.TrackUpdate, now int64) []trackTiming {
+	timing := make([]trackTiming, len(updates))
+	for i, tu := range updates {
+		timing[i].Offset = tu.GetWhen() - started
+		if i < len(updates)-1 {
+			untilNext := max(updates[i+1].GetWhen()-tu.GetWhen(), 0)
+			timing[i].UntilNext = &untilNext
+		}
+	}
+	if len(updates) == 0 || now-updates[len(updates)-1].GetWhen() >= playingTimeout.Milliseconds() {
+		return timing
+	}
+	// the latest track is playing, and so are tracks being mixed out on other
+	// decks. A track isn't playing once another has started on its deck.
+	decks := map[string]bool{}
+	for i := len(updates) - 1; i >= 0; i-- {
+		if i < len(updates)-1 && now-updates[i+1].GetWhen() >= mixWindow.Milliseconds() {
+			break
+		}
+		deckID := updates[i].GetDeckId()
+		if decks[deckID] {
+			continue
+		}
+		decks[deckID] = true
+		timing[i].Playing = true
+	}
+	return timing
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+func TestSessionTiming(t *testing.T) {
+	t.Parallel()
+
+	started := int64(1709326800000)
+	minute := int64(60000)
+	update := func(deckID string, when int64) *trackstar.TrackUpdate {
+		return &trackstar.TrackUpdate{DeckId: deckID, When: started + when}
+	}
+	ptr := func(v int64) *int64 { return &v }
+
+	updates := []*trackstar.TrackUpdate{
+		update("1", 5000),
+		update("2", 4*minute),
+		update("1", 8*minute),
+	}
+	require.Equal(t, []trackTiming{
+		{Offset: 5000, UntilNext: ptr(4*minute - 5000)},
+		{Offset: 4 * minute, UntilNext: ptr(4 * minute)},
+		{Offset: 8 * minute},
+	}, sessionTiming(started, updates, started+24*60*minute), "not live")
+
+	playing := func(now int64) []bool {
+		var p []bool
+		for _, timing := range sessionTiming(started, updates, started+now) {
+			p = append(p, timing.Playing)
+		}
+		return p
+	}
+	require.Equal(t, []bool{false, true, true}, playing(8*minute+10000), "mixing out deck 2")
+	require.Equal(t, []bool{false, false, true}, playing(10*minute), "mixed out")
+	require.Equal(t, []bool{false, false, false}, playing(8*minute+30*minute), "ended")
+
+	updates = []*trackstar.TrackUpdate{
+		update("1", 0),
+		update("2", 4*minute),
+		update("3", 4*minute+20000),
+		update("2", 4*minute+40000),
+	}
+	require.Equal(t, []bool{true, false, true, true}, playing(4*minute+50000), "replaced on its deck")
+	require.Equal(t, []bool{false, false, true, true}, playing(5*minute+10000), "mixed out")
+
+	updates = []*trackstar.TrackUpdate{
+		update("1", 0),
+		update("1", 0),
+	}
+	require.Equal(t, int64(0), *sessionTiming(started, updates, started)[0].UntilNext, "same time")
+	require.Empty(t, sessionTiming(started, nil, started))
+}
@@ -1,7 +1,7 @@
 package server
 
 import (
-	"encoding/csv"
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,11 +13,13 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/autonomouskoi/trackstar-live/server/formats"
+	"github.com/autonomouskoi/trackstar-live/server/store"
 	trackstar "github.com/autonomouskoi/trackstar/pb"
 )
 
 func (s *Server) addTrackUpdate(w http.ResponseWriter, r *http.Request) {
-	userID, err := s.auth.parse(r.Header.Get(headerToken))
+	userID, err := s.authenticate(r)
 	if err != nil {
 		defaultHTTPError(w, http.StatusForbidden)
 		s.logger.Warn("bad token for track update",
@@ -92,7 +94,19 @@ func (s *Server) addTrackUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := s.store.AddTrackUpdate(r.Context(), userID, started, &tu); err != nil {
+	err = s.store.AddTrackUpdate(r.Context(), userID, started, &tu)
+	if errors.Is(err, store.ErrDuplicate) {
+		// the client is retrying an update we already have
+		s.logger.Debug("duplicate track update",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"started", started,
+			"idx", tu.Index,
+		)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err != nil {
 		defaultHTTPError(w, http.StatusInsufficientStorage)
 		s.logger.Error("adding track update",
 			"remote", r.RemoteAddr,
@@ -102,10 +116,22 @@ func (s *Server) addTrackUpdate(w http.ResponseWriter, r *http.Request) {
 		)
 		return
 	}
+	unlisted, err := s.isUnlisted(r.Context(), userID, started)
+	if err != nil {
+		// the update is stored, only the live subscribers miss out
+		s.logger.Error("checking unlisted",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"started", started,
+			"error", err.Error(),
+		)
+		unlisted = true
+	}
 	s.subs.Send(&TrackUpdate{
-		UserID:  userID,
-		Session: started,
-		Update:  &tu,
+		UserID:   userID,
+		Session:  started,
+		Update:   &tu,
+		Unlisted: unlisted,
 	})
 	s.logger.Debug("submitting track update",
 		"remote", r.RemoteAddr,
@@ -119,7 +145,17 @@ func (s *Server) addTrackUpdate(w http.ResponseWriter, r *http.Request) {
 
 func (srv *Server) sessionsList(w http.ResponseWriter, r *http.Request) {
 	userID := r.PathValue("userID")
+	// the owner sees all their sets, everyone else only the listed ones
+	subject, err := srv.authenticate(r)
+	owner := err == nil && subject == userID
+	if srv.privateSets && !owner {
+		http.Error(w, "sets are private", http.StatusForbidden)
+		return
+	}
 	sessions, err := srv.store.SessionsList(r.Context(), userID)
+	if err == nil && !owner {
+		sessions, err = srv.listed(r.Context(), userID, sessions)
+	}
 	if err != nil {
 		defaultHTTPError(w, http.StatusInternalServerError)
 		srv.logger.Error("listing sessions",
@@ -140,6 +176,19 @@ func (srv *Server) sessionGet(w http.ResponseWriter, r *http.Request) {
 		defaultHTTPError(w, http.StatusBadRequest)
 		return
 	}
+	if allowed, err := srv.shareAllowed(r, userID, started); err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("checking share",
+			"remote", r.RemoteAddr,
+			"user_id", userID,
+			"started", startedStr,
+			"error", err.Error(),
+		)
+		return
+	} else if !allowed {
+		defaultHTTPError(w, http.StatusForbidden)
+		return
+	}
 	updates, err := srv.store.SessionGet(r.Context(), userID, started)
 	if err != nil {
 		defaultHTTPError(w, http.StatusInternalServerError)
@@ -151,41 +200,114 @@ func (srv *Server) sessionGet(w http.ResponseWriter, r *http.Request) {
 		)
 		return
 	}
-	switch r.FormValue("download") {
-	case "csv":
-		srv.sendCSV(w, userID, started, updates)
+	download := r.FormValue("download")
+	format := formats.Lookup(download)
+	if format == nil && download == "" {
+		format = formats.ForAccept(r.Header.Get("Accept"))
+	}
+	if format != nil {
+		set := &formats.Set{
+			UserID:  userID,
+			Started: started,
+			Updates: updates,
+		}
+		if err := exportOptions(r, set); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		srv.sendExport(w, format, set)
 		return
 	}
+	// each update has its timing alongside its own fields
 	updatesJSON := struct {
-		Updates []json.RawMessage `json:"updates"`
+		Updates []map[string]json.RawMessage `json:"updates"`
 	}{}
-	for _, update := range updates {
-		b, err := protojson.Marshal(update)
+	timing := sessionTiming(started, updates, time.Now().UnixMilli())
+	for i, update := range updates {
+		fields, err := updateJSON(update, &timing[i])
 		if err != nil {
 			defaultHTTPError(w, http.StatusInternalServerError)
 			srv.logger.Error("marshalling update", "error", err.Error())
 			return
 		}
-		updatesJSON.Updates = append(updatesJSON.Updates, b)
+		updatesJSON.Updates = append(updatesJSON.Updates, fields)
 	}
 	srv.sendJSON(w, updatesJSON)
 }
 
-func (srv *Server) sendCSV(w http.ResponseWriter, userID string, started int64, tracks []*trackstar.TrackUpdate) {
-	w.Header().Set(headerContentType, "text/csv")
-	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s.csv"`, userID, time.UnixMilli(started).Format(time.DateOnly)))
-	csvW := csv.NewWriter(w)
-	csvW.Write([]string{
-		"index", "when", "deck ID", "artist", "title",
-	})
-	for _, tu := range tracks {
-		csvW.Write([]string{
-			strconv.Itoa(int(tu.Index)),
-			time.Unix(tu.GetWhen(), 0).Format(time.RFC3339),
-			tu.GetDeckId(),
-			tu.GetTrack().Artist,
-			tu.GetTrack().Title,
-		})
-	}
-	csvW.Flush()
+// updateJSON combines the fields of tu and its timing
+func updateJSON(tu *trackstar.TrackUpdate, timing *trackTiming) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	b, err := protojson.Marshal(tu)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if b, err = json.Marshal(timing); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// exportOptions sets the options r gives for exporting set. Options that
+// don't apply to a format are ignored by it.
+func exportOptions(r *http.Request, set *formats.Set) error {
+	var err error
+	if offset := r.FormValue("offset"); offset != "" {
+		d, err := time.ParseDuration(offset)
+		if err != nil {
+			return fmt.Errorf("invalid offset: %w", err)
+		}
+		set.Offset = d.Milliseconds()
+	}
+	if tmpl := r.FormValue("template"); tmpl != "" {
+		if set.Template, err = formats.ParseTemplate(tmpl); err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+	}
+	if columns := r.FormValue("columns"); columns != "" {
+		if set.Columns, err = formats.ParseColumns(columns); err != nil {
+			return fmt.Errorf("invalid columns: %w", err)
+		}
+	}
+	if tz := r.FormValue("tz"); tz != "" {
+		if set.Location, err = time.LoadLocation(tz); err != nil {
+			return fmt.Errorf("invalid tz: %w", err)
+		}
+	}
+	if delimiter := r.FormValue("delimiter"); delimiter != "" {
+		if set.Delimiter, err = formats.ParseDelimiter(delimiter); err != nil {
+			return fmt.Errorf("invalid delimiter: %w", err)
+		}
+	}
+	set.File = r.FormValue("file")
+	return nil
+}
+
+// sendExport renders set in format as a download
+func (srv *Server) sendExport(w http.ResponseWriter, format *formats.Format, set *formats.Set) {
+	buf := &bytes.Buffer{}
+	if err := format.Write(buf, set); errors.Is(err, formats.ErrTooManyTracks) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	} else if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("exporting set",
+			"user_id", set.UserID,
+			"started", set.Started,
+			"format", format.Name,
+			"error", err.Error(),
+		)
+		return
+	}
+	w.Header().Set(headerContentType, format.ContentType)
+	w.Header().Set(headerContentLength, strconv.Itoa(buf.Len()))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, format.Filename(set)))
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, buf)
 }
@@ -0,0 +1,21 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	trackstar "github.com/autonomouskoi/trackstar/pb"
+)
+
+// TestIndexFieldNumber checks that the plugin's IndexedTrackUpdate sets the
+// field the server reads as the track update's index
+func TestIndexFieldNumber(t *testing.T) {
+	t.Parallel()
+
+	index := (&trackstar.TrackUpdate{}).ProtoReflect().Descriptor().Fields().ByName("index")
+	require.NotNil(t, index, "trackstar.TrackUpdate has no index field")
+	indexed := (&IndexedTrackUpdate{}).ProtoReflect().Descriptor().Fields().ByName("index")
+	require.Equal(t, indexed.Number(), index.Number())
+	require.Equal(t, indexed.Kind(), index.Kind())
+}
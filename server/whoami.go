@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var errRevoked = errors.New("token revoked")
+
+// tokenScopes are what a token allows. Every token currently has all of them.
+var tokenScopes = []string{"track_update", "share", "refresh"}
+
+// revoked reports whether the token with claims has been revoked. Tokens
+// without an issue time can't be checked, so they count as revoked.
+func (srv *Server) revoked(ctx context.Context, claims *jwt.RegisteredClaims) (bool, error) {
+	if claims.IssuedAt == nil {
+		return true, nil
+	}
+	before, err := srv.store.TokensRevokedBefore(ctx, claims.Subject)
+	if err != nil {
+		return false, fmt.Errorf("checking revocation: %w", err)
+	}
+	return revokedAt(claims.IssuedAt.Time, before), nil
+}
+
+// revokedAt reports whether a token issued at issued is revoked by a
+// revocation of tokens issued before the millisecond before. Issue times only
+// have whole seconds, so a token issued in the same second as the revocation
+// is revoked too.
+func revokedAt(issued time.Time, before int64) bool {
+	if before == 0 {
+		return false
+	}
+	beforeSec := (before + 999) / 1000
+	return issued.Unix() < beforeSec
+}
+
+// authenticate checks the token r was made with, including whether it's been
+// revoked, and returns its subject
+func (srv *Server) authenticate(r *http.Request) (string, error) {
+	claims, err := srv.auth.claims(r.Header.Get(headerToken))
+	if err != nil {
+		return "", err
+	}
+	revoked, err := srv.revoked(r.Context(), claims)
+	if err != nil {
+		return "", err
+	}
+	if revoked {
+		return "", errRevoked
+	}
+	return claims.Subject, nil
+}
+
+// authenticateOwner checks that r's token belongs to the user in its path,
+// replying with an error if not. what names the request in logs.
+func (srv *Server) authenticateOwner(w http.ResponseWriter, r *http.Request, what string) (string, bool) {
+	userID, err := srv.authenticate(r)
+	if err != nil {
+		defaultHTTPError(w, http.StatusForbidden)
+		srv.logger.Warn("bad token for "+what,
+			"remote", r.RemoteAddr,
+			"error", err.Error(),
+		)
+		return "", false
+	}
+	if pathUserID := r.PathValue("userID"); pathUserID != userID {
+		http.Error(w, "token mismatch", http.StatusForbidden)
+		srv.logger.Warn("token mismatch",
+			"path_user_id", pathUserID,
+			"token_user_id", userID,
+		)
+		return "", false
+	}
+	return userID, true
+}
+
+// handleWhoami describes the token the request was made with, so clients can
+// check a token without sending a track. A revoked token is described rather
+// than rejected.
+func (srv *Server) handleWhoami(w http.ResponseWriter, r *http.Request) {
+	claims, err := srv.auth.claims(r.Header.Get(headerToken))
+	if err != nil {
+		defaultHTTPError(w, http.StatusForbidden)
+		srv.logger.Warn("bad token for whoami",
+			"remote", r.RemoteAddr,
+			"error", err.Error(),
+		)
+		return
+	}
+	revoked, err := srv.revoked(r.Context(), claims)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("whoami",
+			"remote", r.RemoteAddr,
+			"user_id", claims.Subject,
+			"error", err.Error(),
+		)
+		return
+	}
+	resp := &WhoamiResponse{
+		Subject: claims.Subject,
+		Scopes:  tokenScopes,
+		Revoked: revoked,
+	}
+	if claims.IssuedAt != nil {
+		resp.IssuedAt = claims.IssuedAt.UnixMilli()
+	}
+	if claims.ExpiresAt != nil {
+		resp.ExpiresAt = claims.ExpiresAt.UnixMilli()
+	}
+	srv.logger.Debug("whoami",
+		"remote", r.RemoteAddr,
+		"user_id", claims.Subject,
+		"revoked", revoked,
+	)
+	if strings.Contains(r.Header.Get("Accept"), contentTypeProto) {
+		srv.sendProto(w, resp)
+		return
+	}
+	b, err := protojson.Marshal(resp)
+	if err != nil {
+		defaultHTTPError(w, http.StatusInternalServerError)
+		srv.logger.Error("marshalling whoami", "error", err.Error())
+		return
+	}
+	srv.sendJSON(w, json.RawMessage(b))
+}
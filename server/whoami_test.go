@@ -0,0 +1,29 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokedAt(t *testing.T) {
+	t.Parallel()
+
+	revocation := time.Date(2026, 10, 19, 12, 0, 0, 500_000_000, time.UTC)
+	before := revocation.UnixMilli()
+	// issue times are truncated to whole seconds in tokens
+	issued := func(d time.Duration) time.Time {
+		return revocation.Add(d).Truncate(time.Second)
+	}
+
+	require.False(t, revokedAt(issued(-time.Hour), 0), "never revoked")
+	require.True(t, revokedAt(issued(-time.Hour), before), "issued well before")
+	require.True(t, revokedAt(issued(-time.Millisecond*100), before), "issued just before, same second")
+	require.True(t, revokedAt(issued(time.Millisecond*100), before), "issued just after, same second")
+	require.False(t, revokedAt(issued(time.Second), before), "issued the next second")
+
+	onTheSecond := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	require.True(t, revokedAt(onTheSecond.Add(-time.Second), onTheSecond.UnixMilli()))
+	require.False(t, revokedAt(onTheSecond, onTheSecond.UnixMilli()), "issued at the revocation")
+}
@@ -36,4 +36,13 @@ class Cfg extends ValueUpdater<livepb.Config> {
         })
     }
 }
-export { Cfg };
\ No newline at end of file
+// errorMessage describes an error rejected by bus.sendAnd, preferring the
+// message meant for the user
+function errorMessage(e: any): string {
+    if (e instanceof buspb.Error) {
+        return e.userMessage || e.detail || `error ${e.code}`;
+    }
+    return `${e}`;
+}
+
+export { Cfg, errorMessage };
\ No newline at end of file
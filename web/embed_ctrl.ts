@@ -4,6 +4,9 @@ import * as livepb from "/m/trackstar-live/pb/trackstar-live/live_pb.js";
 import { Cfg } from './controller.js';
 import { Tokens } from './token.js';
 import { Status } from "./status.js";
+import { Session } from "./session.js";
+import { Rules } from "./rules.js";
+import { ManualTrack } from "./manual.js";
 
 const TOPIC_REQUEST = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_REQUEST);
 
@@ -15,8 +18,11 @@ function start(mainContainer: HTMLElement) {
 
     bus.waitForTopic(TOPIC_REQUEST, 5000)
         .then(() => {
-            mainContainer.appendChild(new Status());
+            mainContainer.appendChild(new Status(cfg));
+            mainContainer.appendChild(new Session(cfg));
+            mainContainer.appendChild(new ManualTrack());
             mainContainer.appendChild(new Tokens(cfg));
+            mainContainer.appendChild(new Rules(cfg));
             cfg.refresh();
         });
 }
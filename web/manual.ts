@@ -0,0 +1,69 @@
+import { bus, enumName } from "/bus.js";
+import * as buspb from "/pb/bus/bus_pb.js";
+
+import * as livepb from "/m/trackstar-live/pb/trackstar-live/live_pb.js";
+import { ControlPanel } from "/tk.js";
+import { errorMessage } from './controller.js';
+
+const TOPIC_COMMAND = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_COMMAND);
+
+let help = document.createElement('div');
+help.innerHTML = `
+<p>
+Publish a track Trackstar didn't detect, e.g. one played from vinyl. It's added to the current
+session and sent like any other track, including applying rules and delays.
+</p>
+`;
+
+class ManualTrack extends ControlPanel {
+    private _deck: HTMLInputElement;
+    private _artist: HTMLInputElement;
+    private _title: HTMLInputElement;
+
+    constructor() {
+        super({ title: 'Manual Track', help });
+
+        this.innerHTML = `
+<style>
+label {
+    font-weight: bold;
+}
+</style>
+<div class="grid-2-col">
+    <label for="artist">Artist</label>
+    <input id="artist" />
+
+    <label for="title">Title</label>
+    <input id="title" />
+
+    <label for="deck">Deck</label>
+    <input id="deck" />
+</div>
+<button id="publish" type="button">Publish</button>
+`;
+
+        this._artist = this.querySelector('input#artist');
+        this._title = this.querySelector('input#title');
+        this._deck = this.querySelector('input#deck');
+        this.querySelector('button#publish').addEventListener('click', () => this._publish());
+    }
+
+    private _publish() {
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_COMMAND,
+            type: livepb.MessageTypeCommand.MANUAL_TRACK_REQ,
+            message: new livepb.ManualTrackRequest({
+                deckId: this._deck.value,
+                artist: this._artist.value,
+                title: this._title.value,
+            }).toBinary(),
+        })).then(() => {
+            this._artist.value = '';
+            this._title.value = '';
+        }).catch((e) => alert(errorMessage(e)));
+    }
+}
+
+customElements.define('trackstar-live-manual-track', ManualTrack, { extends: 'fieldset' });
+
+export { ManualTrack };
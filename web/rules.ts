@@ -0,0 +1,170 @@
+import { bus, enumName } from "/bus.js";
+import * as buspb from "/pb/bus/bus_pb.js";
+import * as livepb from "/m/trackstar-live/pb/trackstar-live/live_pb.js";
+import { Cfg, errorMessage } from './controller.js';
+import { UpdatingControlPanel } from '/tk.js';
+
+const TOPIC_COMMAND = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_COMMAND);
+
+let help = document.createElement('div');
+help.innerHTML = `
+<p>
+Rules control what's published. Each rule matches tracks by deck and by artist and title
+<a href="https://github.com/google/re2/wiki/Syntax" target="_blank">regular expressions</a>.
+Leave a field empty to match anything. Matching tracks are either skipped or have their artist
+and title replaced, e.g. with <code>ID</code> for an unreleased track. Rules are applied in order
+and a skipped track isn't checked against later rules.
+</p>
+<p>
+<em>Deck Names</em> publishes a name instead of a deck's ID. Rules match the original deck ID.
+</p>
+<p>
+<em>Hide Next Track</em> skips the next track, whatever it is.
+</p>
+`;
+
+class Rules extends UpdatingControlPanel<livepb.Config> {
+    private _cfg: Cfg;
+    private _hideNext: HTMLInputElement;
+    private _rules: HTMLTableSectionElement;
+    private _deckNames: HTMLTableSectionElement;
+
+    constructor(cfg: Cfg) {
+        super({ title: 'Rules', help, data: cfg });
+        this._cfg = cfg;
+
+        this.innerHTML = `
+<div>
+    <label for="hide-next">Hide Next Track</label>
+    <input id="hide-next" type="checkbox" />
+</div>
+<table>
+    <caption>Rules</caption>
+    <thead>
+        <tr>
+            <th>Deck</th>
+            <th>Artist Pattern</th>
+            <th>Title Pattern</th>
+            <th>Action</th>
+            <th>Rewrite Artist</th>
+            <th>Rewrite Title</th>
+            <th></th>
+        </tr>
+    </thead>
+    <tbody id="rules"></tbody>
+</table>
+<button id="add-rule" type="button">Add Rule</button>
+<table>
+    <caption>Deck Names</caption>
+    <thead>
+        <tr>
+            <th>Deck</th>
+            <th>Name</th>
+            <th></th>
+        </tr>
+    </thead>
+    <tbody id="deck-names"></tbody>
+</table>
+<button id="add-deck" type="button">Add Deck Name</button>
+<div>
+    <button id="save" type="button">Save</button>
+</div>
+`;
+
+        this._hideNext = this.querySelector('input#hide-next');
+        this._hideNext.addEventListener('change', () => this._setHideNext(this._hideNext.checked));
+        this._rules = this.querySelector('tbody#rules');
+        this._deckNames = this.querySelector('tbody#deck-names');
+
+        this.querySelector('button#add-rule').addEventListener('click', () => this._addRule(new livepb.Rule()));
+        this.querySelector('button#add-deck').addEventListener('click', () => this._addDeckName('', ''));
+        this.querySelector('button#save').addEventListener('click', () => this._save());
+    }
+
+    update(cfg: livepb.Config) {
+        this._hideNext.checked = cfg.hideNext;
+        this._rules.textContent = '';
+        cfg.rules.forEach((rule) => this._addRule(rule));
+        this._deckNames.textContent = '';
+        Object.keys(cfg.deckNames).toSorted().forEach((deckID) => {
+            this._addDeckName(deckID, cfg.deckNames[deckID]);
+        });
+    }
+
+    private _addRule(rule: livepb.Rule) {
+        let tr = document.createElement('tr');
+        tr.innerHTML = `
+<td><input name="deck" size="6" /></td>
+<td><input name="artist-pattern" /></td>
+<td><input name="title-pattern" /></td>
+<td>
+    <select name="action">
+        <option value="${livepb.RuleAction.RULE_ACTION_SKIP}">Skip</option>
+        <option value="${livepb.RuleAction.RULE_ACTION_REWRITE}">Rewrite</option>
+    </select>
+</td>
+<td><input name="rewrite-artist" /></td>
+<td><input name="rewrite-title" /></td>
+<td><button type="button">Delete</button></td>
+`;
+        let input = (name: string): HTMLInputElement => tr.querySelector(`input[name="${name}"]`);
+        input('deck').value = rule.deckId;
+        input('artist-pattern').value = rule.artistPattern;
+        input('title-pattern').value = rule.titlePattern;
+        input('rewrite-artist').value = rule.rewriteArtist;
+        input('rewrite-title').value = rule.rewriteTitle;
+        let action: HTMLSelectElement = tr.querySelector('select');
+        action.value = rule.action.toString();
+        tr.querySelector('button').addEventListener('click', () => tr.remove());
+        this._rules.appendChild(tr);
+    }
+
+    private _addDeckName(deckID: string, name: string) {
+        let tr = document.createElement('tr');
+        tr.innerHTML = `
+<td><input name="deck" size="6" /></td>
+<td><input name="name" /></td>
+<td><button type="button">Delete</button></td>
+`;
+        (tr.querySelector('input[name="deck"]') as HTMLInputElement).value = deckID;
+        (tr.querySelector('input[name="name"]') as HTMLInputElement).value = name;
+        tr.querySelector('button').addEventListener('click', () => tr.remove());
+        this._deckNames.appendChild(tr);
+    }
+
+    private _save() {
+        let cfg = this._cfg.last.clone();
+        cfg.rules = Array.from(this._rules.querySelectorAll('tr')).map((tr) => {
+            let value = (name: string) => (tr.querySelector(`[name="${name}"]`) as HTMLInputElement).value;
+            return new livepb.Rule({
+                deckId: value('deck'),
+                artistPattern: value('artist-pattern'),
+                titlePattern: value('title-pattern'),
+                action: parseInt(value('action')),
+                rewriteArtist: value('rewrite-artist'),
+                rewriteTitle: value('rewrite-title'),
+            });
+        });
+        cfg.deckNames = {};
+        this._deckNames.querySelectorAll('tr').forEach((tr) => {
+            let deckID = (tr.querySelector('input[name="deck"]') as HTMLInputElement).value;
+            let name = (tr.querySelector('input[name="name"]') as HTMLInputElement).value;
+            if (deckID) {
+                cfg.deckNames[deckID] = name;
+            }
+        });
+        this._cfg.save(cfg).catch((e) => alert(errorMessage(e)));
+    }
+
+    private _setHideNext(hide: boolean) {
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_COMMAND,
+            type: livepb.MessageTypeCommand.HIDE_NEXT_REQ,
+            message: new livepb.HideNextRequest({ hide }).toBinary(),
+        })).then(() => this._cfg.refresh())
+            .catch((e) => alert(errorMessage(e)));
+    }
+}
+customElements.define('trackstar-live-rules', Rules, { extends: 'fieldset' });
+
+export { Rules };
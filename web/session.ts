@@ -0,0 +1,167 @@
+import { bus, enumName } from "/bus.js";
+import * as buspb from "/pb/bus/bus_pb.js";
+
+import * as livepb from "/m/trackstar-live/pb/trackstar-live/live_pb.js";
+import { ControlPanel } from "/tk.js";
+import { Cfg, errorMessage } from './controller.js';
+import { tokenLabel } from './status.js';
+
+const TOPIC_EVENT = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_EVENT);
+const TOPIC_REQUEST = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_REQUEST);
+const TOPIC_COMMAND = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_COMMAND);
+
+// matches defaultSessionIdleMinutes in the plugin
+const DEFAULT_IDLE_MINUTES = 240;
+
+let help = document.createElement('div');
+help.innerHTML = `
+<p>
+Tracks are grouped into sessions, one per set. The session is kept when TS Live restarts, but
+once there's been no track for <em>Idle Minutes</em> the next track starts a new session.
+</p>
+<p>
+<em>New Session</em> starts a new set. <em>End Session</em> ends the current set; the next track
+starts a new one. <em>Resume Previous</em> goes back to the previous set, e.g. if a session was
+ended by mistake.
+</p>
+<p>
+<em>Reconcile</em> checks the current session on each server against the tracks TS Live
+published and resends any the server is missing.
+</p>
+`;
+
+class Session extends ControlPanel {
+    private _currentDiv: HTMLDivElement;
+    private _previousDiv: HTMLDivElement;
+    private _resumeButton: HTMLButtonElement;
+    private _idleInput: HTMLInputElement;
+    private _cfg: Cfg;
+
+    constructor(cfg: Cfg) {
+        super({ title: 'Session', help });
+        this._cfg = cfg;
+
+        this.innerHTML = `
+<style>
+label {
+    font-weight: bold;
+}
+</style>
+<div class="grid-2-col">
+    <label for="current">Current Session</label>
+    <div id="current"></div>
+
+    <label for="previous">Previous Session</label>
+    <div id="previous"></div>
+
+    <label for="idle">Idle Minutes</label>
+    <input id="idle" type="number" min="1" step="1" size="5" />
+</div>
+<div class="flex-row" style="gap: 0.5rem">
+    <button id="start">New Session</button>
+    <button id="end">End Session</button>
+    <button id="resume">Resume Previous</button>
+    <button id="reconcile">Reconcile</button>
+</div>
+`;
+
+        this._currentDiv = this.querySelector('div#current');
+        this._previousDiv = this.querySelector('div#previous');
+        this._resumeButton = this.querySelector('button#resume');
+        this._idleInput = this.querySelector('input#idle');
+        this._idleInput.addEventListener('change', () => this._setIdleMinutes());
+        this._cfg.subscribe((cfg) => this._updateIdleMinutes(cfg));
+        this._updateIdleMinutes(this._cfg.last);
+
+        this.querySelector('button#start').addEventListener('click', () => {
+            if (confirm('Start a new session?')) {
+                this._command(livepb.MessageTypeCommand.SESSION_START_REQ, new livepb.SessionStartRequest());
+            }
+        });
+        this.querySelector('button#end').addEventListener('click', () => {
+            this._command(livepb.MessageTypeCommand.SESSION_END_REQ, new livepb.SessionEndRequest());
+        });
+        this._resumeButton.addEventListener('click', () => {
+            this._command(livepb.MessageTypeCommand.SESSION_RESUME_REQ, new livepb.SessionResumeRequest());
+        });
+
+        this.querySelector('button#reconcile').addEventListener('click', () => this._reconcile());
+
+        bus.subscribe(TOPIC_EVENT, (msg) => {
+            if (msg.type === livepb.MessageTypeEvent.SESSION_EVENT) {
+                this._update(livepb.SessionState.fromBinary(msg.message));
+            }
+        });
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_REQUEST,
+            type: livepb.MessageTypeRequest.SESSION_GET_REQ,
+            message: new livepb.GetSessionRequest().toBinary(),
+        })).then((reply) => {
+            this._update(livepb.GetSessionResponse.fromBinary(reply.message).session);
+        });
+    }
+
+    private _command(type: livepb.MessageTypeCommand, req: { toBinary(): Uint8Array }) {
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_COMMAND,
+            type,
+            message: req.toBinary(),
+        })).catch((e) => alert(errorMessage(e)));
+    }
+
+    private _reconcile() {
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_COMMAND,
+            type: livepb.MessageTypeCommand.RECONCILE_REQ,
+            message: new livepb.ReconcileRequest().toBinary(),
+        })).then((reply) => {
+            let resp = livepb.ReconcileResponse.fromBinary(reply.message);
+            let lines = Object.keys(resp.results).toSorted().map((tokenId) => {
+                let result = resp.results[tokenId];
+                let label = tokenLabel(this._cfg, tokenId);
+                if (result.error) {
+                    return `${label}: ${result.error}`;
+                }
+                return `${label}: ${result.missing} missing`;
+            });
+            alert(lines.length ? lines.join('\n') : 'No enabled tokens');
+        }).catch((e) => alert(errorMessage(e)));
+    }
+
+    private _updateIdleMinutes(cfg: livepb.Config) {
+        this._idleInput.value = `${cfg.sessionIdleMinutes || DEFAULT_IDLE_MINUTES}`;
+    }
+
+    private _setIdleMinutes() {
+        let minutes = Math.round(Number(this._idleInput.value));
+        if (!(minutes > 0)) {
+            this._updateIdleMinutes(this._cfg.last);
+            return;
+        }
+        let cfg = this._cfg.last.clone();
+        cfg.sessionIdleMinutes = BigInt(minutes);
+        this._cfg.save(cfg).catch((e) => alert(errorMessage(e)));
+    }
+
+    private _update(session?: livepb.SessionState) {
+        this._currentDiv.innerText = formatSession(session?.current);
+        this._previousDiv.innerText = formatSession(session?.previous);
+        this._resumeButton.disabled = !session?.previous;
+    }
+}
+
+// session IDs are the time the session started, in milliseconds
+function formatSession(session?: string): string {
+    if (!session) {
+        return 'None';
+    }
+    let started = new Date(Number(session));
+    if (isNaN(started.getTime())) {
+        return session;
+    }
+    return `${session} (started ${started.toLocaleString()})`;
+}
+
+customElements.define('trackstar-live-session', Session, { extends: 'fieldset' });
+
+export { Session };
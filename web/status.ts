@@ -4,23 +4,42 @@ import * as buspb from "/pb/bus/bus_pb.js";
 import * as livepb from "/m/trackstar-live/pb/trackstar-live/live_pb.js";
 import * as tspb from "/m/trackstar/pb/trackstar_pb.js";
 import { ControlPanel } from "/tk.js";
+import { Cfg } from './controller.js';
 
 const TOPIC_EVENT = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_EVENT);
+const TOPIC_REQUEST = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_REQUEST);
 
 let help = document.createElement('div');
 help.innerHTML = `
 <p>
 Shows the last track TS Live sent, or the last error that occurred sending a track.
 </p>
+<p>
+Tracks that can't be sent are queued and retried until the server accepts them. <em>Queued</em>
+shows how many tracks are waiting to be sent.
+</p>
+<p>
+<em>Pending</em> lists the tracks waiting to be sent and when each will be published. Tracks
+wait if the token has a delay or if sending to its server failed.
+</p>
+<p>
+<em>Destinations</em> shows, for each token, when a track was last sent successfully, the last
+error sending to that server, and how many sends have succeeded and failed.
+</p>
 `;
 
 class Status extends ControlPanel {
     private _artistDiv: HTMLDivElement;
     private _titleDiv: HTMLDivElement;
     private _errorDiv: HTMLDivElement;
+    private _queuedDiv: HTMLDivElement;
+    private _destinations: HTMLTableSectionElement;
+    private _pending: HTMLTableSectionElement;
+    private _cfg: Cfg;
 
-    constructor() {
+    constructor(cfg: Cfg) {
         super({ title: 'Status', help });
+        this._cfg = cfg;
 
         this.innerHTML = `
 <style>
@@ -37,20 +56,135 @@ label {
 
     <label for="error">Last Error</label>
     <div id="error"></div>
+
+    <label for="queued">Queued</label>
+    <div id="queued"></div>
 </div>
+<table>
+    <caption>Destinations</caption>
+    <thead>
+        <tr>
+            <th>Token</th>
+            <th>Last Success</th>
+            <th>Last Error</th>
+            <th>Sent</th>
+            <th>Failed</th>
+        </tr>
+    </thead>
+    <tbody id="destinations"></tbody>
+</table>
+<table>
+    <caption>Pending</caption>
+    <thead>
+        <tr>
+            <th>Token</th>
+            <th>Artist</th>
+            <th>Title</th>
+            <th>Publishes</th>
+        </tr>
+    </thead>
+    <tbody id="pending"></tbody>
+</table>
 `;
 
         this._artistDiv = this.querySelector('div#artist');
         this._titleDiv = this.querySelector('div#title');
         this._errorDiv = this.querySelector('div#error');
+        this._queuedDiv = this.querySelector('div#queued');
+        this._destinations = this.querySelector('tbody#destinations');
+        this._pending = this.querySelector('tbody#pending');
 
         bus.subscribe(TOPIC_EVENT, (msg) => this._handleTSEvent(msg));
+        this._refreshQueue();
+        this._refreshDestinations();
+    }
+
+    private _refreshPending() {
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_REQUEST,
+            type: livepb.MessageTypeRequest.PENDING_GET_REQ,
+            message: new livepb.GetPendingRequest().toBinary(),
+        })).then((reply) => {
+            let resp = livepb.GetPendingResponse.fromBinary(reply.message);
+            this._pending.textContent = '';
+            resp.pending.forEach((pending) => {
+                let tu = tspb.TrackUpdate.fromBinary(pending.update.trackUpdate);
+                let publishes = 'Now';
+                if (pending.update.heldForNext) {
+                    publishes = 'After the next track';
+                } else if (pending.update.releaseAt > Date.now()) {
+                    publishes = formatTime(pending.update.releaseAt);
+                }
+                let tr = document.createElement('tr');
+                [
+                    tokenLabel(this._cfg, pending.tokenId),
+                    tu.track?.artist ?? '',
+                    tu.track?.title ?? '',
+                    publishes,
+                ].forEach((value) => {
+                    let td = document.createElement('td');
+                    td.innerText = value;
+                    tr.appendChild(td);
+                });
+                this._pending.appendChild(tr);
+            });
+        });
+    }
+
+    private _refreshDestinations() {
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_REQUEST,
+            type: livepb.MessageTypeRequest.STATUS_GET_REQ,
+            message: new livepb.GetStatusRequest().toBinary(),
+        })).then((reply) => {
+            let resp = livepb.GetStatusResponse.fromBinary(reply.message);
+            this._destinations.textContent = '';
+            Object.keys(resp.destinations).toSorted().forEach((tokenId) => {
+                let health = resp.destinations[tokenId];
+                let tr = document.createElement('tr');
+                [
+                    tokenLabel(this._cfg, tokenId),
+                    formatTime(health.lastSuccess),
+                    health.lastError ? `${formatTime(health.lastErrorAt)}: ${health.lastError}` : '',
+                    health.totalSent.toString(),
+                    health.totalFailed.toString(),
+                ].forEach((value) => {
+                    let td = document.createElement('td');
+                    td.innerText = value;
+                    tr.appendChild(td);
+                });
+                this._destinations.appendChild(tr);
+            });
+        });
+    }
+
+    private _refreshQueue() {
+        bus.sendAnd(new buspb.BusMessage({
+            topic: TOPIC_REQUEST,
+            type: livepb.MessageTypeRequest.QUEUE_GET_REQ,
+            message: new livepb.GetQueueRequest().toBinary(),
+        })).then((reply) => {
+            let resp = livepb.GetQueueResponse.fromBinary(reply.message);
+            let depth = Object.values(resp.queues)
+                .reduce((total, queue) => total + queue.depth, 0);
+            this._queuedDiv.innerText = depth.toString();
+        });
+        this._refreshPending();
     }
 
     private _handleTSEvent(msg: buspb.BusMessage) {
+        if (msg.type === livepb.MessageTypeEvent.DESTINATION_HEALTH_EVENT) {
+            this._refreshDestinations();
+            return;
+        }
+        if (msg.type === livepb.MessageTypeEvent.QUEUE_EVENT) {
+            this._refreshQueue();
+            return;
+        }
         if (msg.type !== livepb.MessageTypeEvent.TRACK_SEND_EVENT) {
             return;
         }
+        this._refreshQueue();
         if (msg.error) {
             this._errorDiv.innerText = msg.error.userMessage;
             return;
@@ -60,6 +194,17 @@ label {
         this._titleDiv.innerText = tu.track.title;
     }
 }
+function tokenLabel(cfg: Cfg, tokenId: string): string {
+    return cfg.last.tokens[tokenId]?.label || tokenId;
+}
+
+function formatTime(millis: bigint): string {
+    if (!millis) {
+        return '';
+    }
+    return new Date(Number(millis)).toLocaleString();
+}
+
 customElements.define('trackstar-live-status', Status, { extends: 'fieldset' });
 
-export { Status };
\ No newline at end of file
+export { Status, tokenLabel };
\ No newline at end of file
@@ -1,7 +1,7 @@
 import { bus, enumName } from "/bus.js";
 import * as buspb from "/pb/bus/bus_pb.js";
 import * as livepb from "/m/trackstar-live/pb/trackstar-live/live_pb.js";
-import { Cfg } from './controller.js';
+import { Cfg, errorMessage } from './controller.js';
 import { UpdatingControlPanel } from '/tk.js';
 
 const TOPIC_COMMAND = enumName(livepb.BusTopic, livepb.BusTopic.TRACKSTAR_LIVE_COMMAND);
@@ -17,9 +17,14 @@ given to you by the site operator with someone else!
 `;
 
 interface setTokenParams {
+    tokenId?: string,
     label?: string,
     rawToken?: string,
-    enabled?: boolean
+    enabled?: boolean,
+    delayMs?: bigint,
+    delayUntilNext?: boolean,
+    allowInsecure?: boolean,
+    skipVerify?: boolean,
 }
 
 class Tokens extends UpdatingControlPanel<livepb.Config> {
@@ -37,7 +42,7 @@ class Tokens extends UpdatingControlPanel<livepb.Config> {
 `;
 
         this._tokensDiv = this.querySelector('div#tokens');
-        this._setTokenDialog = new SetTokenDialog((rawToken) => this._setToken({ rawToken }));
+        this._setTokenDialog = new SetTokenDialog((params) => this._setToken(params));
         this._setTokenDialog.close();
         this.appendChild(this._setTokenDialog);
 
@@ -50,24 +55,48 @@ class Tokens extends UpdatingControlPanel<livepb.Config> {
 
     update(cfg: livepb.Config) {
         this._tokensDiv.textContent = '';
-        Object.keys(cfg.tokens).toSorted().forEach((label) => {
+        let byLabel = (a: string, b: string) => cfg.tokens[a].label.localeCompare(cfg.tokens[b].label);
+        Object.keys(cfg.tokens).toSorted(byLabel).forEach((tokenId) => {
+            let tokenCfg = cfg.tokens[tokenId];
             this._tokensDiv.appendChild(new Token({
-                label,
-                tokenCfg: cfg.tokens[label],
-                onEnabled: (enabled) => this._setToken({ label, enabled }),
-                onDelete: () => this._setToken({ label }),
+                tokenId,
+                tokenCfg,
+                onEnabled: (enabled) => this._updateToken({ tokenId, enabled }),
+                onDelete: () => this._updateToken({ tokenId }),
+                onTest: () => this._testConnection(tokenId, tokenCfg.label),
+                onDelay: (delayMs, delayUntilNext) => this._updateToken({ tokenId, delayMs, delayUntilNext }),
+                onRename: (label) => this._updateToken({ tokenId, label }),
             }));
         });
     }
 
-    private _setToken(params: setTokenParams) {
+    private _testConnection(tokenId: string, label: string) {
+        let msg = new buspb.BusMessage({
+            topic: TOPIC_COMMAND,
+            type: livepb.MessageTypeCommand.TEST_CONNECTION_REQ,
+            message: new livepb.TestConnectionRequest({ tokenId }).toBinary(),
+        });
+        bus.sendAnd(msg).then((reply) => {
+            let resp = livepb.TestConnectionResponse.fromBinary(reply.message);
+            if (resp.ok) {
+                alert(`${label}: connected in ${resp.durationMs}ms`);
+            } else {
+                alert(`${label}: ${resp.error}`);
+            }
+        }).catch((e) => alert(`${label}: ${errorMessage(e)}`));
+    }
+
+    private _updateToken(params: setTokenParams) {
+        this._setToken(params).catch((e) => alert(errorMessage(e)));
+    }
+
+    private _setToken(params: setTokenParams): Promise<void> {
         let msg = new buspb.BusMessage({
             topic: TOPIC_COMMAND,
             type: livepb.MessageTypeCommand.TOKEN_SET_REQ,
             message: new livepb.TokenSetRequest(params).toBinary(),
         });
-        bus.sendAnd(msg).then((reply) => {
-            this._setTokenDialog.close();
+        return bus.sendAnd(msg).then((reply) => {
             this._cfg.refresh();
         })
     }
@@ -76,23 +105,29 @@ customElements.define('trackstar-live-tokens', Tokens, { extends: 'fieldset' });
 
 class Token extends HTMLDetailsElement {
 
-    constructor({ label, tokenCfg, onEnabled, onDelete }:
+    constructor({ tokenId, tokenCfg, onEnabled, onDelete, onTest, onDelay, onRename }:
         {
-            label: string,
+            tokenId: string,
             tokenCfg: livepb.TokenConfig,
             onEnabled: (en: boolean) => void,
             onDelete: () => void,
+            onTest: () => void,
+            onDelay: (delayMs: bigint, delayUntilNext: boolean) => void,
+            onRename: (label: string) => void,
         }
     ) {
         super();
 
+        // every token has the same fields, so their IDs are made unique
+        let idFor = (name: string) => `token-${tokenId}-${name}`;
+
         this.innerHTML = `
 <summary>
-        <label for="enabled">Enabled:</label>
-        <input id="enabled" type="checkbox" />
+        <label for="${idFor('enabled')}">Enabled:</label>
+        <input id="${idFor('enabled')}" type="checkbox" />
         <a href=""></a>
 </summary>
-<div class="grid-2-col" id="details"></div>
+<div class="grid-2-col details"></div>
 `
 
         let token = tokenCfg.token;
@@ -100,16 +135,16 @@ class Token extends HTMLDetailsElement {
             return
         }
 
-        let details = this.querySelector('div#details');
+        let details = this.querySelector('div.details');
 
         let add = (id: string, label: string, content: string): HTMLInputElement => {
             let l = document.createElement('label');
-            l.htmlFor = id;
+            l.htmlFor = idFor(id);
             l.innerText = label;
             details.appendChild(l);
 
             let i = document.createElement('input');
-            i.id = id;
+            i.id = idFor(id);
             i.disabled = true;
             i.value = content;
             details.appendChild(i);
@@ -117,6 +152,13 @@ class Token extends HTMLDetailsElement {
             return i;
         };
 
+        let label = add('label', 'Label', tokenCfg.label);
+        label.disabled = false;
+        label.addEventListener('change', () => {
+            if (label.value) {
+                onRename(label.value);
+            }
+        });
         add('user_id', 'User ID', token.subject);
         add('issuer', 'Issuer', token.issuer);
         add('audience', 'Audience', `${token.audience}`);
@@ -127,14 +169,51 @@ class Token extends HTMLDetailsElement {
         enabled.checked = tokenCfg.enabled;
         enabled.addEventListener('change', () => onEnabled(enabled.checked));
         let a: HTMLAnchorElement = this.querySelector('summary>a');
-        a.innerText = label;
-        a.href = label;
+        a.innerText = tokenCfg.label;
+        a.href = viewerURL(token);
+
+        let delayLabel = document.createElement('label');
+        delayLabel.htmlFor = idFor('delay');
+        delayLabel.innerText = 'Delay (minutes)';
+        details.appendChild(delayLabel);
+        let delay = document.createElement('input');
+        delay.id = idFor('delay');
+        delay.type = 'number';
+        delay.min = '0';
+        delay.step = 'any';
+        delay.value = `${Number(tokenCfg.delayMs) / 60000}`;
+        details.appendChild(delay);
+
+        let untilNextLabel = document.createElement('label');
+        untilNextLabel.htmlFor = idFor('delay_until_next');
+        untilNextLabel.innerText = 'Delay Until Next Track';
+        details.appendChild(untilNextLabel);
+        let untilNext = document.createElement('input');
+        untilNext.id = idFor('delay_until_next');
+        untilNext.type = 'checkbox';
+        untilNext.checked = tokenCfg.delayUntilNext;
+        details.appendChild(untilNext);
+
+        delay.disabled = untilNext.checked;
+        let setDelay = () => {
+            delay.disabled = untilNext.checked;
+            let minutes = Math.max(0, parseFloat(delay.value) || 0);
+            onDelay(BigInt(Math.round(minutes * 60000)), untilNext.checked);
+        };
+        delay.addEventListener('change', setDelay);
+        untilNext.addEventListener('change', setDelay);
+
+        let testButton = document.createElement('button');
+        testButton.type = 'button';
+        testButton.innerText = 'Test Connection';
+        testButton.addEventListener('click', () => onTest());
+        details.appendChild(testButton);
 
         let deleteButton = document.createElement('button');
         deleteButton.type = 'button';
         deleteButton.innerText = 'Delete';
         deleteButton.addEventListener('click', () => {
-            if (confirm(`Delete ${label}?`)) {
+            if (confirm(`Delete ${tokenCfg.label}?`)) {
                 onDelete();
             }
         });
@@ -143,11 +222,20 @@ class Token extends HTMLDetailsElement {
 }
 customElements.define('trackstar-live-token', Token, { extends: 'details' });
 
+function viewerURL(token: livepb.Token): string {
+    let u = new URL(token.issuer);
+    u.pathname = `/u/${token.subject}`;
+    return u.toString();
+}
+
 class SetTokenDialog extends HTMLDialogElement {
-    private _set = (token: string) => { };
+    private _set: (params: setTokenParams) => Promise<void>;
     private _ta: HTMLTextAreaElement;
+    private _allowInsecure: HTMLInputElement;
+    private _verify: HTMLInputElement;
+    private _error: HTMLDivElement;
 
-    constructor(set: (token: string) => void) {
+    constructor(set: (params: setTokenParams) => Promise<void>) {
         super();
         this._set = set;
 
@@ -155,11 +243,23 @@ class SetTokenDialog extends HTMLDialogElement {
 <div class="flex-column">
     <h2>Set Token</h2>
     <textarea cols="40" rows="8"></textarea>
+    <div>
+        <input id="verify" type="checkbox" checked />
+        <label for="verify">Verify with server</label>
+    </div>
+    <div>
+        <input id="allow-insecure" type="checkbox" />
+        <label for="allow-insecure">Allow insecure (http) server</label>
+    </div>
+    <div id="error" style="color: red"></div>
     <button id="save" type="button">Save</button>
     <button id="cancel" type="button">Cancel</button>
 </div>`;
 
         this._ta = this.querySelector('textarea');
+        this._verify = this.querySelector('input#verify');
+        this._allowInsecure = this.querySelector('input#allow-insecure');
+        this._error = this.querySelector('div#error');
 
         let cancel: HTMLButtonElement = this.querySelector('button#cancel');
         cancel.addEventListener('click', () => this._close());
@@ -169,12 +269,18 @@ class SetTokenDialog extends HTMLDialogElement {
     }
 
     private _save() {
-        this._set(this._ta.value);
-        this._close();
+        this._error.innerText = '';
+        this._set({
+            rawToken: this._ta.value,
+            allowInsecure: this._allowInsecure.checked,
+            skipVerify: !this._verify.checked,
+        }).then(() => this._close())
+            .catch((e) => this._error.innerText = errorMessage(e));
     }
 
     private _close() {
         this._ta.value = '';
+        this._error.innerText = '';
         this.close();
     }
 }